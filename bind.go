@@ -0,0 +1,356 @@
+package ferrite
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+	"golang.org/x/exp/constraints"
+)
+
+// Bind registers an environment variable for each tagged field of the struct
+// that ptr points to.
+//
+// The fields are populated with the values of their environment variables by
+// the next call to Init(). Fields whose variables are undefined or invalid are
+// left unchanged.
+//
+// A field is bound to an environment variable if it has an "env" tag, which
+// specifies the variable's name. The following tags are also recognized:
+//
+//   - "desc": a human-readable description of the environment variable
+//   - "default": the default value, in the same syntax as the variable itself
+//   - "required": "true" to register a required variable instead of an
+//     optional variable
+//   - "sensitive": "true" to mark the variable as containing sensitive content
+//   - "min", "max": the minimum and maximum acceptable values of a numeric or
+//     duration field
+//   - "enum": a comma-separated list of the acceptable values of a string field
+//
+// Fields may be strings, booleans, integers, floating-point numbers or
+// time.Duration values, including user-defined types with one of those
+// underlying types. Bind panics if a field has an unsupported type or an invalid
+// tag.
+//
+// The options are applied to every variable, for example:
+//
+//	ferrite.Bind(&cfg, ferrite.WithRegistry(reg))
+func Bind(ptr any, options ...BindOption) {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ptr must be a non-nil pointer to a struct, got %T", ptr))
+	}
+
+	var cfg variableSetConfig
+	for _, opt := range options {
+		opt.applyRequiredOptionToConfig(&cfg)
+	}
+
+	if cfg.Registry == nil {
+		cfg.Registry = &variable.DefaultRegistry
+	}
+
+	st := rv.Elem()
+	var populate []func()
+
+	for i := 0; i < st.NumField(); i++ {
+		f, ok := parseBoundField(st.Type(), st.Type().Field(i))
+		if !ok {
+			continue
+		}
+
+		s := bindField(f, options)
+		v := st.Field(i)
+
+		populate = append(
+			populate,
+			func() {
+				if n := s.value(); n != nil {
+					v.Set(reflect.ValueOf(n).Convert(v.Type()))
+				}
+			},
+		)
+	}
+
+	bindings.Lock()
+	defer bindings.Unlock()
+
+	bindings.pending = append(
+		bindings.pending,
+		binding{cfg.Registry, populate},
+	)
+}
+
+// BindOption is an option that configures the variables registered by Bind().
+//
+// Any option that can be applied to both required and optional variables, such
+// as WithRegistry() or SeeAlso(), may be used as a BindOption.
+type BindOption interface {
+	RequiredOption
+	OptionalOption
+}
+
+// bindings is the set of structs that have been bound using Bind() but are yet
+// to be populated by Init().
+var bindings struct {
+	sync.Mutex
+	pending []binding
+}
+
+// binding is a struct that has been bound using Bind().
+type binding struct {
+	Registry *variable.Registry
+	Populate []func()
+}
+
+// populateBindings populates the fields of the structs bound to variables in
+// the given registry.
+func populateBindings(reg *variable.Registry) {
+	bindings.Lock()
+	defer bindings.Unlock()
+
+	pending := bindings.pending[:0]
+
+	for _, b := range bindings.pending {
+		if b.Registry != reg {
+			pending = append(pending, b)
+			continue
+		}
+
+		for _, fn := range b.Populate {
+			fn()
+		}
+	}
+
+	bindings.pending = pending
+}
+
+// boundField describes a struct field that is bound to an environment
+// variable.
+type boundField struct {
+	Field               reflect.StructField
+	Path                string
+	Name, Desc          string
+	Default             maybe.Value[string]
+	Min, Max            maybe.Value[string]
+	Members             []string
+	Required, Sensitive bool
+}
+
+// parseBoundField parses the tags of a struct field.
+//
+// ok is false if the field does not have an "env" tag.
+func parseBoundField(st reflect.Type, sf reflect.StructField) (f boundField, ok bool) {
+	f.Name, ok = sf.Tag.Lookup("env")
+	if !ok {
+		return boundField{}, false
+	}
+
+	f.Field = sf
+	f.Path = sf.Name
+	if n := st.Name(); n != "" {
+		f.Path = n + "." + sf.Name
+	}
+
+	if !sf.IsExported() {
+		panic(fmt.Sprintf("the %s field must be exported to be bound to an environment variable", f.Path))
+	}
+
+	if f.Name == "" {
+		panic(fmt.Sprintf("the %s field has an empty env tag", f.Path))
+	}
+
+	f.Desc = sf.Tag.Get("desc")
+	f.Required = parseBoolTag(f, "required")
+	f.Sensitive = parseBoolTag(f, "sensitive")
+
+	if v, ok := sf.Tag.Lookup("default"); ok {
+		f.Default = maybe.Some(v)
+	}
+
+	if v, ok := sf.Tag.Lookup("min"); ok {
+		f.Min = maybe.Some(v)
+	}
+
+	if v, ok := sf.Tag.Lookup("max"); ok {
+		f.Max = maybe.Some(v)
+	}
+
+	if v, ok := sf.Tag.Lookup("enum"); ok {
+		for _, m := range strings.Split(v, ",") {
+			f.Members = append(f.Members, strings.TrimSpace(m))
+		}
+	}
+
+	return f, true
+}
+
+// parseBoolTag parses a boolean tag of a struct field. It returns false if the
+// tag is not present.
+func parseBoolTag(f boundField, key string) bool {
+	v, ok := f.Field.Tag.Lookup(key)
+	if !ok {
+		return false
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		panic(fmt.Sprintf("the %s field has an invalid %s tag (%s), expected true or false", f.Path, key, v))
+	}
+
+	return b
+}
+
+// parseValueTag parses a tag of a struct field that contains a value of type T.
+func parseValueTag[T any](f boundField, key, v string, m variable.Marshaler[T]) T {
+	n, err := m.Unmarshal(variable.Literal{String: v})
+	if err != nil {
+		panic(fmt.Sprintf("the %s field has an invalid %s tag (%s): %s", f.Path, key, v, err))
+	}
+	return n
+}
+
+var durationType = reflectx.TypeOf[time.Duration]()
+
+// bindField registers the environment variable for a struct field.
+func bindField(f boundField, options []BindOption) VariableSet {
+	t := f.Field.Type
+
+	if len(f.Members) != 0 && t.Kind() != reflect.String {
+		panic(fmt.Sprintf("the %s field has an enum tag, but it is not a string", f.Path))
+	}
+
+	if (!f.Min.IsEmpty() || !f.Max.IsEmpty()) && !isBoundNumeric(t) {
+		panic(fmt.Sprintf("the %s field has a min or max tag, but it is not numeric", f.Path))
+	}
+
+	if t == durationType {
+		b := Duration(f.Name, f.Desc)
+		if v, ok := f.Min.Get(); ok {
+			b.WithMinimum(parseValueTag[time.Duration](f, "min", v, durationMarshaler{}))
+		}
+		if v, ok := f.Max.Get(); ok {
+			b.WithMaximum(parseValueTag[time.Duration](f, "max", v, durationMarshaler{}))
+		}
+		return bindElement[time.Duration](b, f, options)
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		if len(f.Members) != 0 {
+			return bindElement[string](Enum(f.Name, f.Desc).WithMembers(f.Members...), f, options)
+		}
+		return bindElement[string](String(f.Name, f.Desc), f, options)
+	case reflect.Bool:
+		return bindElement[bool](Bool(f.Name, f.Desc), f, options)
+	case reflect.Int:
+		return bindSigned[int](f, options)
+	case reflect.Int8:
+		return bindSigned[int8](f, options)
+	case reflect.Int16:
+		return bindSigned[int16](f, options)
+	case reflect.Int32:
+		return bindSigned[int32](f, options)
+	case reflect.Int64:
+		return bindSigned[int64](f, options)
+	case reflect.Uint:
+		return bindUnsigned[uint](f, options)
+	case reflect.Uint8:
+		return bindUnsigned[uint8](f, options)
+	case reflect.Uint16:
+		return bindUnsigned[uint16](f, options)
+	case reflect.Uint32:
+		return bindUnsigned[uint32](f, options)
+	case reflect.Uint64:
+		return bindUnsigned[uint64](f, options)
+	case reflect.Float32:
+		return bindFloat[float32](f, options)
+	case reflect.Float64:
+		return bindFloat[float64](f, options)
+	default:
+		panic(fmt.Sprintf("the %s field has an unsupported type (%s)", f.Path, t))
+	}
+}
+
+// isBoundNumeric returns true if fields of type t accept the min and max tags.
+func isBoundNumeric(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func bindSigned[T constraints.Signed](f boundField, options []BindOption) VariableSet {
+	b := Signed[T](f.Name, f.Desc)
+	if v, ok := f.Min.Get(); ok {
+		b.WithMinimum(parseValueTag[T](f, "min", v, signedMarshaler[T]{}))
+	}
+	if v, ok := f.Max.Get(); ok {
+		b.WithMaximum(parseValueTag[T](f, "max", v, signedMarshaler[T]{}))
+	}
+	return bindElement[T](b, f, options)
+}
+
+func bindUnsigned[T constraints.Unsigned](f boundField, options []BindOption) VariableSet {
+	b := Unsigned[T](f.Name, f.Desc)
+	if v, ok := f.Min.Get(); ok {
+		b.WithMinimum(parseValueTag[T](f, "min", v, unsignedMarshaler[T]{}))
+	}
+	if v, ok := f.Max.Get(); ok {
+		b.WithMaximum(parseValueTag[T](f, "max", v, unsignedMarshaler[T]{}))
+	}
+	return bindElement[T](b, f, options)
+}
+
+func bindFloat[T constraints.Float](f boundField, options []BindOption) VariableSet {
+	b := Float[T](f.Name, f.Desc)
+	if v, ok := f.Min.Get(); ok {
+		b.WithMinimum(parseValueTag[T](f, "min", v, floatMarshaler[T]{}))
+	}
+	if v, ok := f.Max.Get(); ok {
+		b.WithMaximum(parseValueTag[T](f, "max", v, floatMarshaler[T]{}))
+	}
+	return bindElement[T](b, f, options)
+}
+
+// bindElement applies the options common to all field types to the variable
+// described by eb, then registers it.
+func bindElement[T any](
+	eb ElementBuilder[T],
+	f boundField,
+	options []BindOption,
+) VariableSet {
+	s, b := eb.element()
+
+	if v, ok := f.Default.Get(); ok {
+		b.Default(parseValueTag[T](f, "default", v, s))
+	}
+
+	if f.Sensitive {
+		b.MarkSensitive()
+	}
+
+	if f.Required {
+		opts := make([]RequiredOption, len(options))
+		for i, opt := range options {
+			opts[i] = opt
+		}
+		return required(s, b, opts...)
+	}
+
+	opts := make([]OptionalOption, len(options))
+	for i, opt := range options {
+		opts[i] = opt
+	}
+	return optional(s, b, opts...)
+}
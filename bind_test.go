@@ -0,0 +1,270 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type logLevel string
+
+type boundConfig struct {
+	Name     string        `env:"FERRITE_BIND_NAME" desc:"the application name" required:"true"`
+	Token    string        `env:"FERRITE_BIND_TOKEN" desc:"the API token" sensitive:"true"`
+	Level    logLevel      `env:"FERRITE_BIND_LEVEL" desc:"the log level" enum:"debug, info, warn" default:"info"`
+	Verbose  bool          `env:"FERRITE_BIND_VERBOSE" desc:"enable verbose logging" default:"false"`
+	Port     uint16        `env:"FERRITE_BIND_PORT" desc:"the listen port" min:"1024" default:"8080"`
+	Offset   int32         `env:"FERRITE_BIND_OFFSET" desc:"the clock offset" min:"-100" max:"100"`
+	Ratio    float64       `env:"FERRITE_BIND_RATIO" desc:"the sample ratio" max:"1"`
+	Timeout  time.Duration `env:"FERRITE_BIND_TIMEOUT" desc:"the request timeout" max:"1m" default:"10s"`
+	Internal string
+}
+
+var _ = Describe("func Bind()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("populates the struct when Init() is called", func() {
+		os.Setenv("FERRITE_BIND_NAME", "<name>")
+		os.Setenv("FERRITE_BIND_TOKEN", "<token>")
+		os.Setenv("FERRITE_BIND_LEVEL", "debug")
+		os.Setenv("FERRITE_BIND_VERBOSE", "true")
+		os.Setenv("FERRITE_BIND_PORT", "8443")
+		os.Setenv("FERRITE_BIND_OFFSET", "-50")
+		os.Setenv("FERRITE_BIND_RATIO", "0.25")
+		os.Setenv("FERRITE_BIND_TIMEOUT", "30s")
+
+		cfg := boundConfig{
+			Internal: "<internal>",
+		}
+		Bind(&cfg)
+
+		Expect(cfg).To(Equal(boundConfig{Internal: "<internal>"}))
+
+		Init()
+
+		Expect(cfg).To(Equal(boundConfig{
+			Name:     "<name>",
+			Token:    "<token>",
+			Level:    "debug",
+			Verbose:  true,
+			Port:     8443,
+			Offset:   -50,
+			Ratio:    0.25,
+			Timeout:  30 * time.Second,
+			Internal: "<internal>",
+		}))
+	})
+
+	It("uses default values and leaves fields of undefined optional variables unchanged", func() {
+		os.Setenv("FERRITE_BIND_NAME", "<name>")
+
+		cfg := boundConfig{
+			Offset: 123,
+		}
+		Bind(&cfg)
+		Init()
+
+		Expect(cfg).To(Equal(boundConfig{
+			Name:    "<name>",
+			Level:   "info",
+			Port:    8080,
+			Offset:  123,
+			Timeout: 10 * time.Second,
+		}))
+	})
+
+	It("registers a variable for each tagged field", func() {
+		reg := &variable.Registry{
+			Environment: variable.OSEnvironment,
+		}
+
+		var cfg boundConfig
+		Bind(&cfg, WithRegistry(reg))
+
+		var names []string
+		for _, s := range reg.Specs() {
+			names = append(names, s.Name())
+		}
+
+		Expect(names).To(Equal([]string{
+			"FERRITE_BIND_LEVEL",
+			"FERRITE_BIND_NAME",
+			"FERRITE_BIND_OFFSET",
+			"FERRITE_BIND_PORT",
+			"FERRITE_BIND_RATIO",
+			"FERRITE_BIND_TIMEOUT",
+			"FERRITE_BIND_TOKEN",
+			"FERRITE_BIND_VERBOSE",
+		}))
+
+		Expect(variable.DefaultRegistry.Variables()).To(BeEmpty())
+	})
+
+	It("marks variables as required and sensitive", func() {
+		var cfg boundConfig
+		Bind(&cfg)
+
+		for _, s := range variable.DefaultRegistry.Specs() {
+			Expect(s.IsRequired()).To(
+				Equal(s.Name() == "FERRITE_BIND_NAME"),
+				"unexpected required flag for %s",
+				s.Name(),
+			)
+
+			Expect(s.IsSensitive()).To(
+				Equal(s.Name() == "FERRITE_BIND_TOKEN"),
+				"unexpected sensitive flag for %s",
+				s.Name(),
+			)
+		}
+	})
+
+	It("only populates structs bound to the registry passed to Init()", func() {
+		reg := &variable.Registry{
+			Environment: variable.OSEnvironment,
+		}
+
+		os.Setenv("FERRITE_BIND_NAME", "<name>")
+
+		var cfg boundConfig
+		Bind(&cfg, WithRegistry(reg))
+		Init()
+
+		Expect(cfg.Name).To(BeEmpty())
+
+		Init(WithRegistry(reg))
+
+		Expect(cfg.Name).To(Equal("<name>"))
+	})
+
+	It("does not populate fields of invalid variables", func() {
+		os.Setenv("FERRITE_BIND_NAME", "<name>")
+		os.Setenv("FERRITE_BIND_PORT", "80")
+
+		mode.DefaultConfig.Err = GinkgoWriter
+		mode.DefaultConfig.Exit = func(int) {}
+
+		var cfg boundConfig
+		Bind(&cfg)
+		Init()
+
+		Expect(cfg.Name).To(Equal("<name>"))
+		Expect(cfg.Port).To(BeZero())
+	})
+
+	DescribeTable(
+		"it panics if the struct can not be bound",
+		func(ptr any, expect string) {
+			Expect(func() {
+				Bind(ptr)
+			}).To(PanicWith(expect))
+		},
+		Entry(
+			"not a pointer",
+			boundConfig{},
+			"ptr must be a non-nil pointer to a struct, got ferrite_test.boundConfig",
+		),
+		Entry(
+			"nil pointer",
+			(*boundConfig)(nil),
+			"ptr must be a non-nil pointer to a struct, got *ferrite_test.boundConfig",
+		),
+		Entry(
+			"unexported field",
+			&struct {
+				value string `env:"FERRITE_BIND"`
+			}{},
+			"the value field must be exported to be bound to an environment variable",
+		),
+		Entry(
+			"empty env tag",
+			&struct {
+				Value string `env:""`
+			}{},
+			"the Value field has an empty env tag",
+		),
+		Entry(
+			"unsupported type",
+			&struct {
+				Value []string `env:"FERRITE_BIND" desc:"<desc>"`
+			}{},
+			"the Value field has an unsupported type ([]string)",
+		),
+		Entry(
+			"invalid boolean tag",
+			&struct {
+				Value string `env:"FERRITE_BIND" desc:"<desc>" required:"yes"`
+			}{},
+			"the Value field has an invalid required tag (yes), expected true or false",
+		),
+		Entry(
+			"invalid default",
+			&struct {
+				Value uint `env:"FERRITE_BIND" desc:"<desc>" default:"-1"`
+			}{},
+			`the Value field has an invalid default tag (-1): unrecognized uint syntax`,
+		),
+		Entry(
+			"default outside of limits",
+			&struct {
+				Value uint `env:"FERRITE_BIND" desc:"<desc>" max:"10" default:"20"`
+			}{},
+			`the Value field has an invalid default tag (20): too high, expected 10 or less`,
+		),
+		Entry(
+			"invalid minimum",
+			&struct {
+				Value time.Duration `env:"FERRITE_BIND" desc:"<desc>" min:"forever"`
+			}{},
+			`the Value field has an invalid min tag (forever): time: invalid duration "forever"`,
+		),
+		Entry(
+			"enum on a non-string field",
+			&struct {
+				Value int `env:"FERRITE_BIND" desc:"<desc>" enum:"1,2,3"`
+			}{},
+			"the Value field has an enum tag, but it is not a string",
+		),
+		Entry(
+			"limits on a non-numeric field",
+			&struct {
+				Value string `env:"FERRITE_BIND" desc:"<desc>" min:"a"`
+			}{},
+			"the Value field has a min or max tag, but it is not numeric",
+		),
+	)
+})
+
+func ExampleBind() {
+	defer example()()
+
+	type Config struct {
+		Port    uint16        `env:"FERRITE_PORT" desc:"the port to listen on" min:"1024" default:"8080"`
+		Level   string        `env:"FERRITE_LOG_LEVEL" desc:"the minimum log level" enum:"debug,info,warn" required:"true"`
+		Timeout time.Duration `env:"FERRITE_TIMEOUT" desc:"the request timeout" default:"10s"`
+	}
+
+	var cfg Config
+	ferrite.Bind(&cfg)
+
+	os.Setenv("FERRITE_LOG_LEVEL", "debug")
+	ferrite.Init()
+
+	fmt.Println("port is", cfg.Port)
+	fmt.Println("log level is", cfg.Level)
+	fmt.Println("timeout is", cfg.Timeout)
+
+	// Output:
+	// port is 8080
+	// log level is debug
+	// timeout is 10s
+}
@@ -1,8 +1,36 @@
 package ferrite
 
+import (
+	"fmt"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
 // isBuilderOf makes a static assertion that B meats
 type isBuilderOf[T any, B interface {
 	Required(options ...RequiredOption) Required[T]
 	Optional(options ...OptionalOption) Optional[T]
 	Deprecated(options ...DeprecatedOption) Deprecated[T]
 }] struct{}
+
+// ElementBuilder is a builder that describes the elements of a variable that is
+// composed of several values, such as a list.
+//
+// It is implemented by the builders for variables that produce a single value,
+// such as StringBuilder, DurationBuilder and EnumBuilder.
+type ElementBuilder[T any] interface {
+	element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T])
+}
+
+// documentationSubject returns the phrase used to refer to the variable with the
+// given name within its documentation.
+//
+// The name is empty when the builder is used as an ElementBuilder, in which case
+// the documentation refers to each element of the compound variable instead.
+func documentationSubject(name string) string {
+	if name == "" {
+		return "each element"
+	}
+
+	return fmt.Sprintf("the `%s` variable", name)
+}
@@ -0,0 +1,156 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// BigInt configures an environment variable as an arbitrary-precision integer.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// The value returned by the variable is shared, and must not be modified.
+func BigInt(name, desc string) *BigIntBuilder {
+	b := &BigIntBuilder{
+		schema: variable.TypedBigNumeric[*big.Int]{
+			Marshaler: bigIntMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Integer syntax").
+		Paragraph(
+			"Integers can only be specified using decimal notation.",
+			"A leading positive sign (`+`) is **OPTIONAL**.",
+			"A leading negative sign (`-`) is **REQUIRED** in order to specify a negative value.",
+		).
+		Format().
+		Paragraph(
+			"Internally, %s is represented using an arbitrary-precision integer type (`*big.Int`),",
+			"so there is no limit to the size of the value other than any explicit minimum or maximum.",
+		).
+		Format(documentationSubject(name)).
+		Done()
+
+	return b
+}
+
+// BigIntBuilder builds a specification for an arbitrary-precision integer
+// variable.
+type BigIntBuilder struct {
+	schema    variable.TypedBigNumeric[*big.Int]
+	builder   variable.TypedSpecBuilder[*big.Int]
+	completed bool
+}
+
+var _ isBuilderOf[*big.Int, *BigIntBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *BigIntBuilder) WithDefault(v *big.Int) *BigIntBuilder {
+	b.builder.Default(new(big.Int).Set(v))
+	return b
+}
+
+// WithMinimum sets the minimum acceptable value of the variable.
+func (b *BigIntBuilder) WithMinimum(v *big.Int) *BigIntBuilder {
+	b.schema.NativeMin = maybe.Some(new(big.Int).Set(v))
+	return b
+}
+
+// WithMaximum sets the maximum acceptable value of the variable.
+func (b *BigIntBuilder) WithMaximum(v *big.Int) *BigIntBuilder {
+	b.schema.NativeMax = maybe.Some(new(big.Int).Set(v))
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *BigIntBuilder) Required(options ...RequiredOption) Required[*big.Int] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *BigIntBuilder) Optional(options ...OptionalOption) Optional[*big.Int] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *BigIntBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*big.Int] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *BigIntBuilder) element() (variable.TypedSchema[*big.Int], *variable.TypedSpecBuilder[*big.Int]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds an example that depends on the builder's options.
+//
+// The example is a value larger than can be represented by a uint64, which is
+// more illustrative than the examples generated by the schema. If limits are
+// specified the example is derived from them instead.
+//
+// It has no effect if it has already been called.
+func (b *BigIntBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	offset := new(big.Int).Exp(big.NewInt(10), big.NewInt(21), nil)
+	min, hasMin := b.schema.NativeMin.Get()
+	max, hasMax := b.schema.NativeMax.Get()
+
+	var example *big.Int
+	switch {
+	case hasMin && hasMax:
+		example = new(big.Int).Add(min, max)
+		example.Rsh(example, 1)
+	case hasMin:
+		example = new(big.Int).Add(min, offset)
+	case hasMax:
+		example = new(big.Int).Sub(max, offset)
+	default:
+		example = offset
+	}
+
+	b.builder.NonNormativeExample(example, "")
+}
+
+type bigIntMarshaler struct{}
+
+func (bigIntMarshaler) Marshal(v *big.Int) (variable.Literal, error) {
+	return variable.Literal{
+		String: fmt.Sprintf("%+d", v),
+	}, nil
+}
+
+func (bigIntMarshaler) Unmarshal(v variable.Literal) (*big.Int, error) {
+	s := strings.TrimPrefix(v.String, "+")
+
+	if !isPlainDecimal(strings.TrimPrefix(s, "-")) || strings.Contains(s, ".") {
+		return nil, errors.New("unrecognized integer syntax")
+	}
+
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, errors.New("unrecognized integer syntax")
+	}
+
+	return n, nil
+}
@@ -0,0 +1,289 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type BigIntBuilder", func() {
+	var builder *BigIntBuilder
+
+	BeforeEach(func() {
+		builder = BigInt("FERRITE_BIG_INT", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			BigInt("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			BigInt("FERRITE_BIG_INT", "").Optional()
+		}).To(PanicWith("specification for FERRITE_BIG_INT is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the minimum is greater than the maximum", func() {
+		Expect(func() {
+			builder.
+				WithMinimum(big.NewInt(10)).
+				WithMaximum(big.NewInt(1)).
+				Required()
+		}).To(PanicWith("specification for FERRITE_BIG_INT is invalid: minimum value must not be greater than the maximum value"))
+	})
+
+	It("does not retain references to the values passed to the builder", func() {
+		def := big.NewInt(10)
+
+		v := builder.
+			WithDefault(def).
+			Required()
+
+		def.SetInt64(20)
+
+		Expect(v.Value().Int64()).To(Equal(int64(10)))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid integer", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value, expect string) {
+						os.Setenv("FERRITE_BIG_INT", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal(expect))
+					},
+					Entry("positive", "123", "123"),
+					Entry("explicit positive sign", "+123", "123"),
+					Entry("negative", "-123", "-123"),
+					Entry("zero", "0", "0"),
+					Entry(
+						"larger than uint64",
+						"123456789012345678901234567890",
+						"123456789012345678901234567890",
+					),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value string) {
+						os.Setenv("FERRITE_BIG_INT", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							fmt.Sprintf(
+								`value of FERRITE_BIG_INT (%s) is invalid: unrecognized integer syntax`,
+								value,
+							),
+						))
+					},
+					Entry("not a number", "one"),
+					Entry("fractional", "1.5"),
+					Entry("scientific notation", "1e3"),
+					Entry("hexadecimal", "0x10"),
+					Entry("multiple signs", "--1"),
+					Entry("underscores", "1_000"),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(big.NewInt(-100)).
+							Required().
+							Value()
+
+						Expect(v.Int64()).To(Equal(int64(-100)))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_BIG_INT is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the value is lower than the minimum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_BIG_INT", "-1")
+
+				builder.
+					WithMinimum(big.NewInt(0)).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_BIG_INT (-1) is invalid: too low, expected +0 or greater`,
+			))
+		})
+	})
+
+	When("the value is greater than the maximum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_BIG_INT", "100000000000000000000")
+
+				builder.
+					WithMinimum(big.NewInt(0)).
+					WithMaximum(new(big.Int).Exp(big.NewInt(2), big.NewInt(64), nil)).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_BIG_INT (100000000000000000000) is invalid: too high, expected between +0 and +18446744073709551616`,
+			))
+		})
+	})
+})
+
+func ExampleBigInt_required() {
+	defer example()()
+
+	v := ferrite.
+		BigInt("FERRITE_BIG_INT", "example arbitrary-precision integer variable").
+		Required()
+
+	os.Setenv("FERRITE_BIG_INT", "123456789012345678901234567890")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 123456789012345678901234567890
+}
+
+func ExampleBigInt_default() {
+	defer example()()
+
+	v := ferrite.
+		BigInt("FERRITE_BIG_INT", "example arbitrary-precision integer variable").
+		WithDefault(big.NewInt(-123)).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is -123
+}
+
+func ExampleBigInt_optional() {
+	defer example()()
+
+	v := ferrite.
+		BigInt("FERRITE_BIG_INT", "example arbitrary-precision integer variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleBigInt_limits() {
+	defer example()()
+
+	v := ferrite.
+		BigInt("FERRITE_BIG_INT", "example arbitrary-precision integer variable").
+		WithMinimum(big.NewInt(-5)).
+		WithMaximum(big.NewInt(10)).
+		Required()
+
+	os.Setenv("FERRITE_BIG_INT", "-2")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is -2
+}
+
+func ExampleBigInt_validation() {
+	defer example()()
+
+	os.Setenv("FERRITE_BIG_INT", "+123")
+	ferrite.
+		BigInt("FERRITE_BIG_INT", "example arbitrary-precision integer variable").
+		Required()
+
+	os.Setenv("FERRITE_BIG_INT_LIMITS", "-10")
+	ferrite.
+		BigInt("FERRITE_BIG_INT_LIMITS", "example integer variable with limits").
+		WithMinimum(big.NewInt(0)).
+		Required()
+
+	os.Setenv("FERRITE_BIG_INT_INVALID", "1.5")
+	ferrite.
+		BigInt("FERRITE_BIG_INT_INVALID", "example invalid integer variable").
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_BIG_INT          example arbitrary-precision integer variable    <integer>    ✓ set to +123
+	//  ❯ FERRITE_BIG_INT_INVALID  example invalid integer variable                <integer>    ✗ set to 1.5, expected integer
+	//  ❯ FERRITE_BIG_INT_LIMITS   example integer variable with limits            +0 ...       ✗ set to -10, too low, expected +0 or greater
+	//
+	// <process exited with error code 1>
+}
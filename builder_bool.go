@@ -86,3 +86,7 @@ func (b *BoolBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
 func (b *BoolBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
 	return deprecated(b.schema, &b.builder, options...)
 }
+
+func (b *BoolBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
@@ -0,0 +1,285 @@
+package ferrite
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Bytes configures an environment variable as binary data, such as a signing
+// key or encryption key, that is represented as text using base64 or hex.
+//
+// The data is encoded using standard (padded) base64 by default. Use
+// WithEncoding() to select a different encoding.
+//
+// Bytes variables are sensitive by default, meaning their values are never
+// printed to the console or included in generated documentation.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Bytes(name, desc string) *BytesBuilder {
+	b := &BytesBuilder{
+		schema: variable.TypedBinary[[]byte]{
+			Enc: Base64Encoding,
+		},
+		sensitive: true,
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// BytesEncoding is an encoding used to represent binary data as text.
+type BytesEncoding string
+
+const (
+	// Base64Encoding is the standard base64 encoding, as defined in RFC 4648,
+	// including padding characters.
+	Base64Encoding BytesEncoding = "base64"
+
+	// Base64URLEncoding is the URL-safe base64 encoding, as defined in RFC
+	// 4648, including padding characters.
+	Base64URLEncoding BytesEncoding = "base64url"
+
+	// RawBase64Encoding is the standard base64 encoding, as defined in RFC
+	// 4648, without padding characters.
+	RawBase64Encoding BytesEncoding = "unpadded base64"
+
+	// RawBase64URLEncoding is the URL-safe base64 encoding, as defined in RFC
+	// 4648, without padding characters.
+	RawBase64URLEncoding BytesEncoding = "unpadded base64url"
+
+	// HexEncoding is the hexadecimal encoding, which uses two digits per byte.
+	HexEncoding BytesEncoding = "hex"
+)
+
+// BytesBuilder builds a specification for a binary variable.
+type BytesBuilder struct {
+	schema    variable.TypedBinary[[]byte]
+	builder   variable.TypedSpecBuilder[[]byte]
+	sensitive bool
+	completed bool
+}
+
+var _ isBuilderOf[[]byte, *BytesBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *BytesBuilder) WithDefault(v []byte) *BytesBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithEncoding sets the encoding used to represent the data as text.
+func (b *BytesBuilder) WithEncoding(e BytesEncoding) *BytesBuilder {
+	switch e {
+	case Base64Encoding,
+		Base64URLEncoding,
+		RawBase64Encoding,
+		RawBase64URLEncoding,
+		HexEncoding:
+		b.schema.Enc = e
+	default:
+		panic(fmt.Sprintf("unknown encoding %q", e))
+	}
+
+	return b
+}
+
+// WithLength sets the exact length of the data, in bytes, after it has been
+// decoded.
+func (b *BytesBuilder) WithLength(n int) *BytesBuilder {
+	b.schema.MinLen = maybe.Some(n)
+	b.schema.MaxLen = maybe.Some(n)
+	return b
+}
+
+// WithMinimumLength sets the minimum length of the data, in bytes, after it has
+// been decoded.
+func (b *BytesBuilder) WithMinimumLength(n int) *BytesBuilder {
+	b.schema.MinLen = maybe.Some(n)
+	return b
+}
+
+// WithMaximumLength sets the maximum length of the data, in bytes, after it has
+// been decoded.
+func (b *BytesBuilder) WithMaximumLength(n int) *BytesBuilder {
+	b.schema.MaxLen = maybe.Some(n)
+	return b
+}
+
+// WithNonSensitiveContent marks the variable as not containing sensitive
+// content.
+//
+// Values of non-sensitive variables may be printed to the console and included
+// in generated documentation.
+func (b *BytesBuilder) WithNonSensitiveContent() *BytesBuilder {
+	b.sensitive = false
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *BytesBuilder) Required(options ...RequiredOption) Required[[]byte] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *BytesBuilder) Optional(options ...OptionalOption) Optional[[]byte] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *BytesBuilder) Deprecated(options ...DeprecatedOption) Deprecated[[]byte] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *BytesBuilder) element() (variable.TypedSchema[[]byte], *variable.TypedSpecBuilder[[]byte]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete marks the variable as sensitive if necessary and adds the
+// documentation that depends on the builder's options.
+//
+// It has no effect if it has already been called.
+func (b *BytesBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	if b.sensitive {
+		b.builder.MarkSensitive()
+	}
+
+	enc := b.schema.Enc.(BytesEncoding)
+	doc := b.builder.Documentation().Summary("Binary data encoding")
+
+	switch enc {
+	case Base64Encoding, RawBase64Encoding:
+		doc = doc.
+			Paragraph(
+				"The value is encoded using the standard base64 alphabet described in RFC 4648,",
+				"which consists of the characters `A-Z`, `a-z`, `0-9`, `+` and `/`.",
+			).
+			Format()
+	case Base64URLEncoding, RawBase64URLEncoding:
+		doc = doc.
+			Paragraph(
+				"The value is encoded using the URL-safe base64 alphabet described in RFC 4648,",
+				"which consists of the characters `A-Z`, `a-z`, `0-9`, `-` and `_`.",
+			).
+			Format()
+	case HexEncoding:
+		doc = doc.
+			Paragraph(
+				"The value is encoded as hexadecimal, using two digits for each byte.",
+				"Both uppercase and lowercase digits are accepted.",
+			).
+			Format()
+	}
+
+	switch enc {
+	case Base64Encoding, Base64URLEncoding:
+		doc = doc.
+			Paragraph(
+				"The encoded value **MUST** be padded with `=` characters",
+				"such that its length is a multiple of four.",
+			).
+			Format()
+	case RawBase64Encoding, RawBase64URLEncoding:
+		doc = doc.
+			Paragraph(
+				"The encoded value **MUST NOT** include any `=` padding characters.",
+			).
+			Format()
+	}
+
+	n := 32
+	if min, ok := b.schema.MinLen.Get(); ok {
+		n = min
+	} else if max, ok := b.schema.MaxLen.Get(); ok && max < n {
+		n = max
+	}
+
+	switch enc {
+	case Base64Encoding:
+		doc = doc.
+			Paragraph(
+				"A suitable random value can be generated using `openssl rand -base64 %d`.",
+			).
+			Format(n)
+	case HexEncoding:
+		doc = doc.
+			Paragraph(
+				"A suitable random value can be generated using `openssl rand -hex %d`.",
+			).
+			Format(n)
+	}
+
+	doc.Done()
+}
+
+// Name returns a short human-readable name for the encoding.
+func (e BytesEncoding) Name() string {
+	return string(e)
+}
+
+// EncodeToString returns the textual representation of data.
+func (e BytesEncoding) EncodeToString(data []byte) string {
+	switch e {
+	case Base64URLEncoding:
+		return base64.URLEncoding.EncodeToString(data)
+	case RawBase64Encoding:
+		return base64.RawStdEncoding.EncodeToString(data)
+	case RawBase64URLEncoding:
+		return base64.RawURLEncoding.EncodeToString(data)
+	case HexEncoding:
+		return hex.EncodeToString(data)
+	default:
+		return base64.StdEncoding.EncodeToString(data)
+	}
+}
+
+// DecodeString returns the binary data represented by s.
+func (e BytesEncoding) DecodeString(s string) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch e {
+	case Base64URLEncoding:
+		data, err = base64.URLEncoding.DecodeString(s)
+	case RawBase64Encoding:
+		data, err = base64.RawStdEncoding.DecodeString(s)
+	case RawBase64URLEncoding:
+		data, err = base64.RawURLEncoding.DecodeString(s)
+	case HexEncoding:
+		data, err = hex.DecodeString(s)
+	default:
+		data, err = base64.StdEncoding.DecodeString(s)
+	}
+
+	if err != nil {
+		return nil, errors.New(
+			strings.TrimPrefix(err.Error(), "encoding/hex: "),
+		)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,346 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type BytesBuilder", func() {
+	var builder *BytesBuilder
+
+	BeforeEach(func() {
+		builder = Bytes("FERRITE_BYTES", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Bytes("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Bytes("FERRITE_BYTES", "").Optional()
+		}).To(PanicWith("specification for FERRITE_BYTES is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the encoding is unknown", func() {
+		Expect(func() {
+			builder.WithEncoding("base32")
+		}).To(PanicWith(`unknown encoding "base32"`))
+	})
+
+	It("panics if the minimum length is less than one", func() {
+		Expect(func() {
+			builder.
+				WithMinimumLength(0).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_BYTES is invalid: minimum length: must be at least 1"))
+	})
+
+	It("panics if the maximum length is less than the minimum length", func() {
+		Expect(func() {
+			builder.
+				WithMinimumLength(16).
+				WithMaximumLength(8).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_BYTES is invalid: maximum length: must be at least 16"))
+	})
+
+	It("panics if the default value is the wrong length", func() {
+		Expect(func() {
+			builder.
+				WithLength(4).
+				WithDefault([]byte("abc")).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_BYTES is invalid: default value: too short, expected exactly 4 bytes when decoded, got 3"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the decoded value",
+					func(enc BytesEncoding, value string) {
+						os.Setenv("FERRITE_BYTES", value)
+
+						v := builder.
+							WithEncoding(enc).
+							Required().
+							Value()
+
+						Expect(v).To(Equal([]byte{0xfb, 0xff, 0xbf}))
+					},
+					Entry("standard base64", Base64Encoding, "+/+/"),
+					Entry("URL-safe base64", Base64URLEncoding, "-_-_"),
+					Entry("unpadded standard base64", RawBase64Encoding, "+/+/"),
+					Entry("unpadded URL-safe base64", RawBase64URLEncoding, "-_-_"),
+					Entry("lowercase hex", HexEncoding, "fbffbf"),
+					Entry("uppercase hex", HexEncoding, "FBFFBF"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(enc BytesEncoding, value, expect string) {
+						os.Setenv("FERRITE_BYTES", value)
+
+						Expect(func() {
+							builder.
+								WithEncoding(enc).
+								WithNonSensitiveContent().
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"invalid base64 character",
+						Base64Encoding,
+						"ab!d",
+						`value of FERRITE_BYTES ('ab!d') is invalid: illegal base64 data at input byte 2`,
+					),
+					Entry(
+						"padding on unpadded base64",
+						RawBase64Encoding,
+						"YWJj=",
+						`value of FERRITE_BYTES (YWJj=) is invalid: illegal base64 data at input byte 4`,
+					),
+					Entry(
+						"odd-length hex",
+						HexEncoding,
+						"abc",
+						`value of FERRITE_BYTES (abc) is invalid: odd length hex string`,
+					),
+					Entry(
+						"invalid hex digit",
+						HexEncoding,
+						"zz",
+						`value of FERRITE_BYTES (zz) is invalid: invalid byte: U+007A 'z'`,
+					),
+				)
+			})
+		})
+
+		When("the decoded value is the wrong length", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value string, configure func(*BytesBuilder) *BytesBuilder, expect string) {
+						os.Setenv("FERRITE_BYTES", value)
+
+						Expect(func() {
+							configure(builder).
+								WithEncoding(HexEncoding).
+								WithNonSensitiveContent().
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"shorter than the exact length",
+						"0102",
+						func(b *BytesBuilder) *BytesBuilder { return b.WithLength(4) },
+						`value of FERRITE_BYTES (0102) is invalid: too short, expected exactly 4 bytes when decoded, got 2`,
+					),
+					Entry(
+						"longer than the exact length",
+						"0102030405",
+						func(b *BytesBuilder) *BytesBuilder { return b.WithLength(4) },
+						`value of FERRITE_BYTES (0102030405) is invalid: too long, expected exactly 4 bytes when decoded, got 5`,
+					),
+					Entry(
+						"shorter than the minimum length",
+						"0102",
+						func(b *BytesBuilder) *BytesBuilder { return b.WithMinimumLength(4) },
+						`value of FERRITE_BYTES (0102) is invalid: too short, expected 4 bytes or more when decoded, got 2`,
+					),
+					Entry(
+						"longer than the maximum length",
+						"0102030405",
+						func(b *BytesBuilder) *BytesBuilder { return b.WithMaximumLength(4) },
+						`value of FERRITE_BYTES (0102030405) is invalid: too long, expected 4 bytes or fewer when decoded, got 5`,
+					),
+					Entry(
+						"outside of the length range",
+						"0102",
+						func(b *BytesBuilder) *BytesBuilder { return b.WithMinimumLength(4).WithMaximumLength(8) },
+						`value of FERRITE_BYTES (0102) is invalid: too short, expected between 4 and 8 bytes when decoded, got 2`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault([]byte("<value>")).
+							Required().
+							Value()
+
+						Expect(v).To(Equal([]byte("<value>")))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_BYTES is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleBytes_required() {
+	defer example()()
+
+	v := ferrite.
+		Bytes("FERRITE_BYTES", "example binary variable").
+		Required()
+
+	os.Setenv("FERRITE_BYTES", "PHZhbHVlPg==")
+	ferrite.Init()
+
+	fmt.Printf("value is %s\n", v.Value())
+
+	// Output:
+	// value is <value>
+}
+
+func ExampleBytes_default() {
+	defer example()()
+
+	v := ferrite.
+		Bytes("FERRITE_BYTES", "example binary variable").
+		WithDefault([]byte("<default>")).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Printf("value is %s\n", v.Value())
+
+	// Output:
+	// value is <default>
+}
+
+func ExampleBytes_optional() {
+	defer example()()
+
+	v := ferrite.
+		Bytes("FERRITE_BYTES", "example binary variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Printf("value is %s\n", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleBytes_length() {
+	defer example()()
+
+	v := ferrite.
+		Bytes("FERRITE_BYTES", "example binary variable").
+		WithEncoding(ferrite.HexEncoding).
+		WithLength(4).
+		Required()
+
+	os.Setenv("FERRITE_BYTES", "DEADBEEF")
+	ferrite.Init()
+
+	fmt.Printf("value is %x\n", v.Value())
+
+	// Output:
+	// value is deadbeef
+}
+
+func ExampleBytes_sensitive() {
+	defer example()()
+
+	os.Setenv("FERRITE_BYTES", "c2VjcmV0")
+	ferrite.
+		Bytes("FERRITE_BYTES", "example binary variable").
+		WithLength(32).
+		Required()
+
+	ferrite.Init()
+
+	// Note that the variable's value is obscured in the console output.
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_BYTES  example binary variable    <base64>    ✗ set to ********, too short, expected exactly 32 bytes when decoded, got 6
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleBytes_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_BYTES", "deadbeef")
+	v := ferrite.
+		Bytes("FERRITE_BYTES", "example binary variable").
+		WithEncoding(ferrite.HexEncoding).
+		WithNonSensitiveContent().
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Printf("value is %x\n", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_BYTES  example binary variable  [ <hex> ]  ⚠ deprecated variable set to deadbeef
+	//
+	// value is deadbeef
+}
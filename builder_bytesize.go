@@ -0,0 +1,289 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// ByteSize configures an environment variable as a size in bytes, such as the
+// size of a cache or buffer.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// Sizes may be specified as a number of bytes, or using SI or IEC units, such
+// as "512", "64KiB", "10MB" or "1.5GiB".
+func ByteSize(name, desc string) *ByteSizeBuilder {
+	b := &ByteSizeBuilder{
+		schema: variable.TypedNumeric[uint64]{
+			Marshaler: byteSizeMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Byte size syntax").
+		Paragraph(
+			"Sizes are specified as a number of bytes, optionally followed by a unit,",
+			"such as `512`, `64KiB`, `10MB` or `1.5GiB`.",
+		).
+		Format().
+		Paragraph(
+			"Both SI units (`KB`, `MB`, `GB`, `TB`, `PB` and `EB`), which are powers of 1000,",
+			"and IEC units (`KiB`, `MiB`, `GiB`, `TiB`, `PiB` and `EiB`), which are powers of 1024, are supported.",
+			"The unit prefix is not case-sensitive, but the `B` suffix must be uppercase,",
+			"as a lowercase `b` usually denotes bits rather than bytes.",
+			"Fractional values are allowed only if they represent a whole number of bytes.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// ByteSizeBuilder builds a specification for a byte size variable.
+type ByteSizeBuilder struct {
+	schema    variable.TypedNumeric[uint64]
+	builder   variable.TypedSpecBuilder[uint64]
+	completed bool
+}
+
+var _ isBuilderOf[uint64, *ByteSizeBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *ByteSizeBuilder) WithDefault(v uint64) *ByteSizeBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithMinimum sets the minimum acceptable value of the variable, in bytes.
+func (b *ByteSizeBuilder) WithMinimum(v uint64) *ByteSizeBuilder {
+	b.schema.NativeMin = maybe.Some(v)
+	return b
+}
+
+// WithMaximum sets the maximum acceptable value of the variable, in bytes.
+func (b *ByteSizeBuilder) WithMaximum(v uint64) *ByteSizeBuilder {
+	b.schema.NativeMax = maybe.Some(v)
+	return b
+}
+
+// WithCanonicalUnit sets the unit used to display values of the variable, such
+// as "MiB" or "GB".
+//
+// By default, values are displayed using the largest unit that represents the
+// value as a whole number.
+func (b *ByteSizeBuilder) WithCanonicalUnit(unit string) *ByteSizeBuilder {
+	u, ok := lookupByteSizeUnit(unit)
+	if !ok {
+		panic(fmt.Sprintf("unknown byte size unit %q", unit))
+	}
+
+	b.schema.Marshaler = byteSizeMarshaler{
+		Unit: u,
+	}
+
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *ByteSizeBuilder) Required(options ...RequiredOption) Required[uint64] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *ByteSizeBuilder) Optional(options ...OptionalOption) Optional[uint64] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *ByteSizeBuilder) Deprecated(options ...DeprecatedOption) Deprecated[uint64] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *ByteSizeBuilder) element() (variable.TypedSchema[uint64], *variable.TypedSpecBuilder[uint64]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds an example that depends on the builder's options.
+//
+// The example is the power of two within the permitted range that is closest
+// to 64MiB, which is more illustrative than the examples generated by the
+// schema.
+//
+// It has no effect if it has already been called.
+func (b *ByteSizeBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	var (
+		example  uint64
+		distance = math.MaxInt
+	)
+
+	for exp := 10; exp < 64; exp++ {
+		v := uint64(1) << exp
+
+		if lo, ok := b.schema.NativeMin.Get(); ok && v < lo {
+			continue
+		}
+
+		if hi, ok := b.schema.NativeMax.Get(); ok && v > hi {
+			continue
+		}
+
+		d := exp - 26
+		if d < 0 {
+			d = -d
+		}
+
+		if d < distance {
+			example, distance = v, d
+		}
+	}
+
+	if example != 0 {
+		b.builder.NonNormativeExample(example, "")
+	}
+}
+
+// byteSizeUnit is a unit of measurement for byte sizes.
+type byteSizeUnit struct {
+	Symbol string
+	Size   uint64
+}
+
+// byteSizeUnits is the set of supported units, in ascending order of size.
+var byteSizeUnits = []byteSizeUnit{
+	{"B", 1},
+	{"KB", 1e3},
+	{"KiB", 1 << 10},
+	{"MB", 1e6},
+	{"MiB", 1 << 20},
+	{"GB", 1e9},
+	{"GiB", 1 << 30},
+	{"TB", 1e12},
+	{"TiB", 1 << 40},
+	{"PB", 1e15},
+	{"PiB", 1 << 50},
+	{"EB", 1e18},
+	{"EiB", 1 << 60},
+}
+
+// lookupByteSizeUnit returns the unit with the given symbol.
+//
+// The case of the unit prefix is ignored, but the "B" suffix must be
+// uppercase, as "b" usually denotes bits.
+func lookupByteSizeUnit(symbol string) (byteSizeUnit, bool) {
+	if !strings.HasSuffix(symbol, "B") {
+		return byteSizeUnit{}, false
+	}
+
+	for _, u := range byteSizeUnits {
+		if strings.EqualFold(u.Symbol, symbol) {
+			return u, true
+		}
+	}
+
+	return byteSizeUnit{}, false
+}
+
+type byteSizeMarshaler struct {
+	// Unit is the unit used to marshal values. If it is the zero-value the
+	// largest unit that represents the value as a whole number is used.
+	Unit byteSizeUnit
+}
+
+func (m byteSizeMarshaler) Marshal(v uint64) (variable.Literal, error) {
+	unit := m.Unit
+
+	if unit.Size == 0 {
+		unit = byteSizeUnits[0]
+
+		if v != 0 {
+			for _, u := range byteSizeUnits {
+				if v%u.Size == 0 {
+					unit = u
+				}
+			}
+		}
+	}
+
+	n := new(big.Rat).SetFrac(
+		new(big.Int).SetUint64(v),
+		new(big.Int).SetUint64(unit.Size),
+	)
+
+	// Units are products of powers of 2 and 5, so any fraction has an exact
+	// decimal representation of no more than 60 digits.
+	s := n.FloatString(60)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+
+	return variable.Literal{
+		String: s + unit.Symbol,
+	}, nil
+}
+
+func (m byteSizeMarshaler) Unmarshal(v variable.Literal) (uint64, error) {
+	i := strings.IndexFunc(v.String, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i == -1 {
+		i = len(v.String)
+	}
+
+	number := v.String[:i]
+	symbol := strings.TrimSpace(v.String[i:])
+
+	if number == "" || number == "." || strings.Count(number, ".") > 1 {
+		return 0, errors.New("unrecognized byte size syntax")
+	}
+
+	unit := byteSizeUnits[0]
+	if symbol != "" {
+		var ok bool
+		unit, ok = lookupByteSizeUnit(symbol)
+		if !ok {
+			return 0, fmt.Errorf("unknown unit %q", symbol)
+		}
+	}
+
+	n, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return 0, errors.New("unrecognized byte size syntax")
+	}
+
+	n.Mul(n, new(big.Rat).SetInt(new(big.Int).SetUint64(unit.Size)))
+
+	if !n.IsInt() {
+		return 0, errors.New("must be a whole number of bytes")
+	}
+
+	if !n.Num().IsUint64() {
+		return 0, fmt.Errorf(
+			"too high, expected the largest uint64 value of %d or less",
+			uint64(math.MaxUint64),
+		)
+	}
+
+	return n.Num().Uint64(), nil
+}
@@ -0,0 +1,284 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type ByteSizeBuilder", func() {
+	var builder *ByteSizeBuilder
+
+	BeforeEach(func() {
+		builder = ByteSize("FERRITE_BYTE_SIZE", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			ByteSize("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			ByteSize("FERRITE_BYTE_SIZE", "").Optional()
+		}).To(PanicWith("specification for FERRITE_BYTE_SIZE is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the canonical unit is unknown", func() {
+		Expect(func() {
+			builder.WithCanonicalUnit("XB")
+		}).To(PanicWith(`unknown byte size unit "XB"`))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid byte size", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect uint64) {
+						os.Setenv("FERRITE_BYTE_SIZE", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("bytes", "512", uint64(512)),
+					Entry("bytes with unit", "512B", uint64(512)),
+					Entry("SI unit", "10MB", uint64(10_000_000)),
+					Entry("IEC unit", "64KiB", uint64(64*1024)),
+					Entry("fractional value", "1.5GiB", uint64(1536*1024*1024)),
+					Entry("whitespace before unit", "10 MB", uint64(10_000_000)),
+					Entry("lowercase unit prefix", "64kiB", uint64(64*1024)),
+					Entry("largest value", "18446744073709551615", uint64(18446744073709551615)),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_BYTE_SIZE", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing number",
+						"MiB",
+						`value of FERRITE_BYTE_SIZE (MiB) is invalid: unrecognized byte size syntax`,
+					),
+					Entry(
+						"negative number",
+						"-1MiB",
+						`value of FERRITE_BYTE_SIZE (-1MiB) is invalid: unrecognized byte size syntax`,
+					),
+					Entry(
+						"unknown unit",
+						"10XB",
+						`value of FERRITE_BYTE_SIZE (10XB) is invalid: unknown unit "XB"`,
+					),
+					Entry(
+						"lowercase bytes suffix",
+						"64kib",
+						`value of FERRITE_BYTE_SIZE (64kib) is invalid: unknown unit "kib"`,
+					),
+					Entry(
+						"fractional number of bytes",
+						"1.5B",
+						`value of FERRITE_BYTE_SIZE (1.5B) is invalid: must be a whole number of bytes`,
+					),
+					Entry(
+						"overflow",
+						"16EiB",
+						`value of FERRITE_BYTE_SIZE (16EiB) is invalid: too high, expected the largest uint64 value of 18446744073709551615 or less`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(64 * 1024).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(uint64(64 * 1024)))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_BYTE_SIZE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the value is lower than the minimum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_BYTE_SIZE", "512")
+
+				builder.
+					WithMinimum(1024).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_BYTE_SIZE (512) is invalid: too low, expected 1KiB or greater`,
+			))
+		})
+	})
+
+	When("the value is greater than the maximum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_BYTE_SIZE", "2GB")
+
+				builder.
+					WithMaximum(1 << 30).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_BYTE_SIZE (2GB) is invalid: too high, expected 1GiB or less`,
+			))
+		})
+	})
+})
+
+func ExampleByteSize_required() {
+	defer example()()
+
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte size variable").
+		Required()
+
+	os.Setenv("FERRITE_BYTE_SIZE", "64KiB")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 65536
+}
+
+func ExampleByteSize_default() {
+	defer example()()
+
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte size variable").
+		WithDefault(10_000_000).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 10000000
+}
+
+func ExampleByteSize_optional() {
+	defer example()()
+
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte size variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleByteSize_limits() {
+	defer example()()
+
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte size variable").
+		WithMinimum(1 << 20).
+		WithMaximum(1 << 30).
+		Required()
+
+	os.Setenv("FERRITE_BYTE_SIZE", "1.5MiB")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 1572864
+}
+
+func ExampleByteSize_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_BYTE_SIZE", "1.5GiB")
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte size variable").
+		WithCanonicalUnit("GiB").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_BYTE_SIZE  example byte size variable  [ <uint64> ]  ⚠ deprecated variable set to 1.5GiB
+	//
+	// value is 1610612736
+}
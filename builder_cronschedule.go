@@ -0,0 +1,438 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// CronSchedule configures an environment variable as a cron schedule.
+//
+// Schedules use the standard 5-field cron syntax, such as "0 3 * * *", or one
+// of the predefined macros, such as "@daily".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func CronSchedule(name, desc string) *CronScheduleBuilder {
+	b := &CronScheduleBuilder{
+		schema: variable.TypedOther[Schedule]{
+			Marshaler:   scheduleMarshaler{},
+			Requirement: "**MUST** be a valid cron schedule",
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.NonNormativeExample(mustParseSchedule("0 3 * * *"), "every day at 03:00")
+	b.builder.NonNormativeExample(mustParseSchedule("*/15 * * * *"), "every 15 minutes")
+	b.builder.NonNormativeExample(mustParseSchedule("0 9 * * MON-FRI"), "at 09:00 on weekdays")
+	b.builder.NonNormativeExample(mustParseSchedule("@hourly"), "at the start of every hour")
+	buildCronScheduleSyntaxDocumentation(b.builder.Documentation())
+
+	return b
+}
+
+// CronScheduleBuilder builds a specification for a cron schedule variable.
+type CronScheduleBuilder struct {
+	schema  variable.TypedOther[Schedule]
+	builder variable.TypedSpecBuilder[Schedule]
+}
+
+var _ isBuilderOf[Schedule, *CronScheduleBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. It panics if
+// v is not a valid cron schedule.
+func (b *CronScheduleBuilder) WithDefault(v string) *CronScheduleBuilder {
+	b.builder.Default(mustParseSchedule(v))
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *CronScheduleBuilder) Required(options ...RequiredOption) Required[Schedule] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *CronScheduleBuilder) Optional(options ...OptionalOption) Optional[Schedule] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *CronScheduleBuilder) Deprecated(options ...DeprecatedOption) Deprecated[Schedule] {
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *CronScheduleBuilder) element() (variable.TypedSchema[Schedule], *variable.TypedSpecBuilder[Schedule]) {
+	return b.schema, &b.builder
+}
+
+// Schedule is a cron schedule that describes a recurring set of times.
+type Schedule struct {
+	expr                 string
+	minute, hour, dom    scheduleField
+	month, dow           scheduleField
+	isDOMStar, isDOWStar bool
+}
+
+// Next returns the earliest time after t that matches the schedule.
+//
+// The schedule is evaluated in t's location. Times are matched to the minute;
+// the seconds and nanoseconds of the result are always zero. Local times that
+// are skipped by a daylight saving transition never match, and local times
+// that are repeated may match twice. It returns the zero time if there is no
+// matching time within the next five years.
+func (s Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + 5
+
+	for t.Year() <= limit {
+		if !s.month.has(int(t.Month())) {
+			t = advanceSchedule(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+			continue
+		}
+
+		if !s.matchesDay(t) {
+			t = advanceSchedule(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+			continue
+		}
+
+		if !s.hour.has(t.Hour()) {
+			t = advanceSchedule(t, time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc))
+			continue
+		}
+
+		if !s.minute.has(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// advanceSchedule returns next if it is after t, otherwise it returns the
+// minute after t.
+//
+// time.Date() normalizes a local time that is skipped by a daylight saving
+// transition to a time that may be before the transition, which would
+// otherwise prevent Next() from making progress.
+func advanceSchedule(t, next time.Time) time.Time {
+	if next.After(t) {
+		return next
+	}
+	return t.Add(time.Minute)
+}
+
+// String returns the schedule's cron expression.
+func (s Schedule) String() string {
+	return s.expr
+}
+
+// matchesDay returns true if the date of t matches the day-of-month and
+// day-of-week fields.
+//
+// As is conventional for cron, if both fields are restricted (that is, neither
+// begins with an asterisk) the day matches if either field matches.
+func (s Schedule) matchesDay(t time.Time) bool {
+	dom := s.dom.has(t.Day())
+	dow := s.dow.has(int(t.Weekday()))
+
+	if s.isDOMStar || s.isDOWStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// scheduleField is a bit-set of the values that match a single field of a
+// cron schedule.
+type scheduleField uint64
+
+// has returns true if n is in the set.
+func (f scheduleField) has(n int) bool {
+	return f&(1<<n) != 0
+}
+
+// scheduleFieldSpec describes the permitted values of a single field of a cron
+// schedule.
+type scheduleFieldSpec struct {
+	Name     string
+	Min, Max int
+	Names    []string
+}
+
+var (
+	scheduleMinute = scheduleFieldSpec{Name: "minute", Min: 0, Max: 59}
+	scheduleHour   = scheduleFieldSpec{Name: "hour", Min: 0, Max: 23}
+	scheduleDOM    = scheduleFieldSpec{Name: "day-of-month", Min: 1, Max: 31}
+	scheduleMonth  = scheduleFieldSpec{
+		Name: "month", Min: 1, Max: 12,
+		Names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"},
+	}
+	scheduleDOW = scheduleFieldSpec{
+		Name: "day-of-week", Min: 0, Max: 7,
+		Names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+	}
+)
+
+// scheduleMacros is a map of macro name to the equivalent cron expression.
+var scheduleMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// parseSchedule parses a cron expression.
+func parseSchedule(expr string) (Schedule, error) {
+	fields := strings.Fields(expr)
+
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+		macro := strings.ToLower(fields[0])
+		equiv, ok := scheduleMacros[macro]
+		if !ok {
+			return Schedule{}, fmt.Errorf(
+				"unrecognized macro (%s), expected %s",
+				fields[0],
+				inlineList([]string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}),
+			)
+		}
+
+		s, err := parseSchedule(equiv)
+		s.expr = macro
+		return s, err
+	}
+
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf(
+			"expected 5 space-separated fields (minute, hour, day-of-month, month and day-of-week), got %d",
+			len(fields),
+		)
+	}
+
+	s := Schedule{
+		expr:      strings.Join(fields, " "),
+		isDOMStar: strings.HasPrefix(fields[2], "*"),
+		isDOWStar: strings.HasPrefix(fields[4], "*"),
+	}
+
+	for i, p := range []struct {
+		Spec  scheduleFieldSpec
+		Field *scheduleField
+	}{
+		{scheduleMinute, &s.minute},
+		{scheduleHour, &s.hour},
+		{scheduleDOM, &s.dom},
+		{scheduleMonth, &s.month},
+		{scheduleDOW, &s.dow},
+	} {
+		f, err := p.Spec.parse(fields[i])
+		if err != nil {
+			return Schedule{}, fmt.Errorf("%s field: %w", p.Spec.Name, err)
+		}
+		*p.Field = f
+	}
+
+	// Sunday may be specified as either 0 or 7.
+	if s.dow.has(7) {
+		s.dow |= 1
+		s.dow &^= 1 << 7
+	}
+
+	if err := s.checkDaysExist(); err != nil {
+		return Schedule{}, err
+	}
+
+	return s, nil
+}
+
+// mustParseSchedule parses a cron expression, or panics if it is invalid.
+func mustParseSchedule(expr string) Schedule {
+	s, err := parseSchedule(expr)
+	if err != nil {
+		panic(fmt.Sprintf("invalid cron schedule (%s): %s", expr, err))
+	}
+	return s
+}
+
+// checkDaysExist returns an error if the schedule can never match because the
+// days of the month that it specifies do not occur in any of its months.
+func (s Schedule) checkDaysExist() error {
+	if s.isDOMStar || !s.isDOWStar {
+		return nil
+	}
+
+	daysInMonth := []int{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	first := bits.TrailingZeros64(uint64(s.dom))
+
+	for m := 1; m <= 12; m++ {
+		if s.month.has(m) && first <= daysInMonth[m-1] {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"schedule never matches, day %d does not occur in any of the specified months",
+		first,
+	)
+}
+
+// parse parses a single field of a cron expression.
+func (spec scheduleFieldSpec) parse(field string) (scheduleField, error) {
+	var f scheduleField
+
+	for _, item := range strings.Split(field, ",") {
+		if item == "" {
+			return 0, errors.New("list must not contain empty items")
+		}
+
+		r, step, hasStep := strings.Cut(item, "/")
+		lo, hi := spec.Min, spec.Max
+
+		if r != "*" {
+			a, b, isRange := strings.Cut(r, "-")
+
+			var err error
+			lo, err = spec.parseValue(a)
+			if err != nil {
+				return 0, err
+			}
+
+			if isRange {
+				hi, err = spec.parseValue(b)
+				if err != nil {
+					return 0, err
+				}
+
+				if lo > hi {
+					return 0, fmt.Errorf("range (%s) must not end before it starts", r)
+				}
+			} else if !hasStep {
+				hi = lo
+			}
+		}
+
+		n := 1
+		if hasStep {
+			var err error
+			n, err = strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("step (%s) must be a positive integer", step)
+			}
+		}
+
+		for v := lo; v <= hi; v += n {
+			f |= 1 << v
+		}
+	}
+
+	return f, nil
+}
+
+// parseValue parses a single numeric or named value within a field.
+func (spec scheduleFieldSpec) parseValue(v string) (int, error) {
+	for i, n := range spec.Names {
+		if strings.EqualFold(v, n) {
+			return spec.Min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		if len(spec.Names) != 0 {
+			return 0, fmt.Errorf(
+				"unrecognized value (%s), expected a number between %d and %d, or a name such as %s",
+				v,
+				spec.Min,
+				spec.Max,
+				spec.Names[0],
+			)
+		}
+
+		return 0, fmt.Errorf(
+			"unrecognized value (%s), expected a number between %d and %d",
+			v,
+			spec.Min,
+			spec.Max,
+		)
+	}
+
+	if n < spec.Min || n > spec.Max {
+		return 0, fmt.Errorf(
+			"value (%d) is out of range, expected a number between %d and %d",
+			n,
+			spec.Min,
+			spec.Max,
+		)
+	}
+
+	return n, nil
+}
+
+type scheduleMarshaler struct{}
+
+func (scheduleMarshaler) Marshal(v Schedule) (variable.Literal, error) {
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (scheduleMarshaler) Unmarshal(v variable.Literal) (Schedule, error) {
+	return parseSchedule(v.String)
+}
+
+func buildCronScheduleSyntaxDocumentation(d variable.DocumentationBuilder) {
+	d.
+		Summary("Cron schedule syntax").
+		Paragraph(
+			"A schedule consists of five fields separated by spaces.",
+			"In order, the fields are:",
+			"the minute (`0` to `59`),",
+			"the hour (`0` to `23`),",
+			"the day of the month (`1` to `31`),",
+			"the month (`1` to `12`, or `JAN` to `DEC`)",
+			"and the day of the week (`0` to `7`, or `SUN` to `SAT`, where both `0` and `7` are Sunday).",
+		).
+		Format().
+		Paragraph(
+			"Each field may be `*` to match every value,",
+			"a single value such as `5`,",
+			"a range such as `1-5`,",
+			"or a comma-separated list of values and ranges such as `1,15,30-35`.",
+			"A step may be appended to `*`, a range or a value to match every nth value,",
+			"such as `*/15` for every 15 minutes, or `0-30/10` for minutes `0`, `10`, `20` and `30`.",
+		).
+		Format().
+		Paragraph(
+			"If both the day of the month and the day of the week are restricted,",
+			"that is, neither field begins with `*`,",
+			"the schedule matches days that satisfy either field.",
+		).
+		Format().
+		Paragraph(
+			"Alternatively, the schedule may be one of the following macros:",
+			"`@yearly` or `@annually` (`0 0 1 1 *`),",
+			"`@monthly` (`0 0 1 * *`),",
+			"`@weekly` (`0 0 * * 0`),",
+			"`@daily` or `@midnight` (`0 0 * * *`)",
+			"and `@hourly` (`0 * * * *`).",
+		).
+		Format().
+		Done()
+}
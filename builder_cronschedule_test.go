@@ -0,0 +1,367 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type CronScheduleBuilder", func() {
+	var builder *CronScheduleBuilder
+
+	BeforeEach(func() {
+		builder = CronSchedule("FERRITE_CRON_SCHEDULE", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			CronSchedule("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			CronSchedule("FERRITE_CRON_SCHEDULE", "").Optional()
+		}).To(PanicWith("specification for FERRITE_CRON_SCHEDULE is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		Expect(func() {
+			builder.WithDefault("* * *")
+		}).To(PanicWith("invalid cron schedule (* * *): expected 5 space-separated fields (minute, hour, day-of-month, month and day-of-week), got 3"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid schedule", func() {
+			Describe("func Value()", func() {
+				It("returns the schedule", func() {
+					os.Setenv("FERRITE_CRON_SCHEDULE", "0 3 * * *")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v.String()).To(Equal("0 3 * * *"))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_CRON_SCHEDULE", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"too few fields",
+						"0 3 * *",
+						`value of FERRITE_CRON_SCHEDULE ('0 3 * *') is invalid: expected 5 space-separated fields (minute, hour, day-of-month, month and day-of-week), got 4`,
+					),
+					Entry(
+						"unrecognized macro",
+						"@every",
+						`value of FERRITE_CRON_SCHEDULE (@every) is invalid: unrecognized macro (@every), expected @yearly, @annually, @monthly, @weekly, @daily, @midnight or @hourly`,
+					),
+					Entry(
+						"value out of range",
+						"60 * * * *",
+						`value of FERRITE_CRON_SCHEDULE ('60 * * * *') is invalid: minute field: value (60) is out of range, expected a number between 0 and 59`,
+					),
+					Entry(
+						"unrecognized value",
+						"0 0 * * SUNDAY",
+						`value of FERRITE_CRON_SCHEDULE ('0 0 * * SUNDAY') is invalid: day-of-week field: unrecognized value (SUNDAY), expected a number between 0 and 7, or a name such as SUN`,
+					),
+					Entry(
+						"range ends before it starts",
+						"0 5-1 * * *",
+						`value of FERRITE_CRON_SCHEDULE ('0 5-1 * * *') is invalid: hour field: range (5-1) must not end before it starts`,
+					),
+					Entry(
+						"invalid step",
+						"*/0 * * * *",
+						`value of FERRITE_CRON_SCHEDULE ('*/0 * * * *') is invalid: minute field: step (0) must be a positive integer`,
+					),
+					Entry(
+						"empty list item",
+						"0,,30 * * * *",
+						`value of FERRITE_CRON_SCHEDULE ('0,,30 * * * *') is invalid: minute field: list must not contain empty items`,
+					),
+					Entry(
+						"day that never occurs",
+						"0 0 30 2 *",
+						`value of FERRITE_CRON_SCHEDULE ('0 0 30 2 *') is invalid: schedule never matches, day 30 does not occur in any of the specified months`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("@daily").
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("@daily"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_CRON_SCHEDULE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("type Schedule", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	schedule := func(expr string) Schedule {
+		os.Setenv("FERRITE_CRON_SCHEDULE", expr)
+		defer tearDown()
+
+		return CronSchedule("FERRITE_CRON_SCHEDULE", "<desc>").
+			Required().
+			Value()
+	}
+
+	Describe("func Next()", func() {
+		DescribeTable(
+			"it returns the next matching time",
+			func(expr string, now, expect time.Time) {
+				Expect(schedule(expr).Next(now)).To(Equal(expect))
+			},
+			Entry(
+				"every minute",
+				"* * * * *",
+				time.Date(2024, 1, 1, 10, 20, 30, 0, time.UTC),
+				time.Date(2024, 1, 1, 10, 21, 0, 0, time.UTC),
+			),
+			Entry(
+				"exactly on a matching time",
+				"0 3 * * *",
+				time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+			),
+			Entry(
+				"step",
+				"*/15 * * * *",
+				time.Date(2024, 1, 1, 10, 20, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+			),
+			Entry(
+				"step from a value",
+				"5/20 * * * *",
+				time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC),
+			),
+			Entry(
+				"list and range",
+				"0 9,17 * * MON-FRI",
+				time.Date(2024, 1, 5, 18, 0, 0, 0, time.UTC), // Friday
+				time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),  // Monday
+			),
+			Entry(
+				"sunday as 7",
+				"0 0 * * 7",
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), // Monday
+				time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC), // Sunday
+			),
+			Entry(
+				"month names",
+				"0 0 1 jun *",
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			),
+			Entry(
+				"day of month or day of week",
+				"0 0 15 * MON",
+				time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),  // Tuesday
+				time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), // Monday the 15th
+			),
+			Entry(
+				"leap day",
+				"0 0 29 2 *",
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+			),
+			Entry(
+				"yearly macro",
+				"@yearly",
+				time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			),
+			Entry(
+				"weekly macro",
+				"@weekly",
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+			),
+			Entry(
+				"hourly macro",
+				"@hourly",
+				time.Date(2024, 1, 1, 10, 20, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC),
+			),
+		)
+
+		It("uses the location of the given time", func() {
+			loc := time.FixedZone("UTC+10", 10*60*60)
+
+			Expect(
+				schedule("0 3 * * *").Next(time.Date(2024, 1, 1, 12, 0, 0, 0, loc)),
+			).To(Equal(
+				time.Date(2024, 1, 2, 3, 0, 0, 0, loc),
+			))
+		})
+
+		It("skips local times that do not exist due to daylight saving transitions", func() {
+			loc, err := time.LoadLocation("America/New_York")
+			if err != nil {
+				Skip("time zone database is not available")
+			}
+
+			Expect(
+				schedule("30 2 * * *").Next(time.Date(2024, 3, 9, 12, 0, 0, 0, loc)),
+			).To(Equal(
+				time.Date(2024, 3, 11, 2, 30, 0, 0, loc),
+			))
+		})
+	})
+
+	Describe("func String()", func() {
+		DescribeTable(
+			"it returns the canonical expression",
+			func(expr, expect string) {
+				Expect(schedule(expr).String()).To(Equal(expect))
+			},
+			Entry("expression", "0 3 * * *", "0 3 * * *"),
+			Entry("extra whitespace", " 0  3 * *\t* ", "0 3 * * *"),
+			Entry("macro", "@DAILY", "@daily"),
+		)
+	})
+})
+
+func ExampleCronSchedule_required() {
+	defer example()()
+
+	v := ferrite.
+		CronSchedule("FERRITE_CRON_SCHEDULE", "example cron schedule variable").
+		Required()
+
+	os.Setenv("FERRITE_CRON_SCHEDULE", "0 3 * * *")
+	ferrite.Init()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	fmt.Println("next run is", v.Value().Next(now))
+
+	// Output:
+	// next run is 2024-01-02 03:00:00 +0000 UTC
+}
+
+func ExampleCronSchedule_default() {
+	defer example()()
+
+	v := ferrite.
+		CronSchedule("FERRITE_CRON_SCHEDULE", "example cron schedule variable").
+		WithDefault("@hourly").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is @hourly
+}
+
+func ExampleCronSchedule_optional() {
+	defer example()()
+
+	v := ferrite.
+		CronSchedule("FERRITE_CRON_SCHEDULE", "example cron schedule variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleCronSchedule_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_CRON_SCHEDULE", "@DAILY")
+	v := ferrite.
+		CronSchedule("FERRITE_CRON_SCHEDULE", "example cron schedule variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_CRON_SCHEDULE  example cron schedule variable  [ <string> ]  ⚠ deprecated variable set to @DAILY, equivalent to @daily
+	//
+	// value is @daily
+}
@@ -0,0 +1,227 @@
+package ferrite
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Custom configures an environment variable as a value of an application-defined
+// type T.
+//
+// If T implements both encoding.TextMarshaler and encoding.TextUnmarshaler,
+// either directly or via a pointer receiver, those methods are used to convert
+// between the environment variable value and T. Otherwise, WithMarshaler() must
+// be used to supply the conversion functions.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Custom[T any](name, desc string) *CustomBuilder[T] {
+	b := &CustomBuilder[T]{}
+
+	if isTextMarshalable[T]() {
+		b.schema.Marshaler = textMarshaler[T]{}
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// CustomBuilder builds a specification for a variable of an application-defined
+// type.
+type CustomBuilder[T any] struct {
+	schema  variable.TypedOther[T]
+	builder variable.TypedSpecBuilder[T]
+}
+
+var _ isBuilderOf[struct{}, *CustomBuilder[struct{}]]
+
+// WithMarshaler sets the functions used to convert between the environment
+// variable value and T.
+//
+// marshal returns the canonical string representation of a value. unmarshal
+// parses an environment variable value, returning an error that describes the
+// problem if it is invalid.
+//
+// It takes precedence over T's implementation of encoding.TextMarshaler and
+// encoding.TextUnmarshaler, if any.
+func (b *CustomBuilder[T]) WithMarshaler(
+	marshal func(T) (string, error),
+	unmarshal func(string) (T, error),
+) *CustomBuilder[T] {
+	if marshal == nil || unmarshal == nil {
+		panic("marshal and unmarshal functions must not be nil")
+	}
+
+	b.schema.Marshaler = funcMarshaler[T]{marshal, unmarshal}
+	return b
+}
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *CustomBuilder[T]) WithDefault(v T) *CustomBuilder[T] {
+	b.builder.Default(v)
+	return b
+}
+
+// WithExample adds an example value to the variable's documentation.
+//
+// Examples are normative, meaning that they are guaranteed to be accepted by
+// the variable. desc is an optional human-readable description of the example.
+func (b *CustomBuilder[T]) WithExample(v T, desc string) *CustomBuilder[T] {
+	b.builder.NormativeExample(v, desc)
+	return b
+}
+
+// WithDocumentation adds documentation about the variable's syntax.
+//
+// summary is a short plain-text summary of the documentation, such as "Color
+// syntax". Each paragraph may contain simple inline Markdown formatting.
+func (b *CustomBuilder[T]) WithDocumentation(
+	summary, paragraph string,
+	additional ...string,
+) *CustomBuilder[T] {
+	doc := b.builder.Documentation().Summary(summary)
+
+	for _, p := range append([]string{paragraph}, additional...) {
+		doc = doc.Paragraph("%s").Format(p)
+	}
+
+	doc.Done()
+
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *CustomBuilder[T]) WithConstraint(
+	desc string,
+	fn func(T) bool,
+) *CustomBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithSensitiveContent marks the variable as containing sensitive content.
+//
+// Values of sensitive variables are not printed to the console or included in
+// generated documentation.
+func (b *CustomBuilder[T]) WithSensitiveContent() *CustomBuilder[T] {
+	b.builder.MarkSensitive()
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *CustomBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *CustomBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *CustomBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *CustomBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete checks that the builder has a means of marshaling values.
+func (b *CustomBuilder[T]) complete() {
+	if b.schema.Marshaler == nil {
+		panic(fmt.Sprintf(
+			"%s does not implement encoding.TextMarshaler and encoding.TextUnmarshaler, use WithMarshaler() to supply marshaling functions",
+			reflectx.TypeOf[T](),
+		))
+	}
+}
+
+var (
+	textMarshalerType   = reflectx.TypeOf[encoding.TextMarshaler]()
+	textUnmarshalerType = reflectx.TypeOf[encoding.TextUnmarshaler]()
+)
+
+// isTextMarshalable returns true if T, or a pointer to T, implements both
+// encoding.TextMarshaler and encoding.TextUnmarshaler.
+func isTextMarshalable[T any]() bool {
+	t := reflectx.TypeOf[T]()
+	p := reflect.PointerTo(t)
+
+	canMarshal := t.Implements(textMarshalerType) || p.Implements(textMarshalerType)
+	canUnmarshal := p.Implements(textUnmarshalerType) ||
+		(t.Kind() == reflect.Pointer && t.Implements(textUnmarshalerType))
+
+	return canMarshal && canUnmarshal
+}
+
+// textMarshaler is a marshaler that uses the encoding.TextMarshaler and
+// encoding.TextUnmarshaler implementations of T.
+type textMarshaler[T any] struct{}
+
+func (textMarshaler[T]) Marshal(v T) (variable.Literal, error) {
+	m, ok := any(v).(encoding.TextMarshaler)
+	if !ok {
+		m = any(&v).(encoding.TextMarshaler)
+	}
+
+	data, err := m.MarshalText()
+	if err != nil {
+		return variable.Literal{}, err
+	}
+
+	return variable.Literal{
+		String: string(data),
+	}, nil
+}
+
+func (textMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
+	var value T
+
+	// If T is itself a pointer type, allocate the value it points to so that
+	// UnmarshalText() is not called on a nil pointer.
+	if t := reflectx.TypeOf[T](); t.Kind() == reflect.Pointer {
+		value = reflect.New(t.Elem()).Interface().(T)
+	}
+
+	u, ok := any(&value).(encoding.TextUnmarshaler)
+	if !ok {
+		u = any(value).(encoding.TextUnmarshaler)
+	}
+
+	err := u.UnmarshalText([]byte(v.String))
+	return value, err
+}
+
+// funcMarshaler is a marshaler that uses application-supplied functions.
+type funcMarshaler[T any] struct {
+	marshal   func(T) (string, error)
+	unmarshal func(string) (T, error)
+}
+
+func (m funcMarshaler[T]) Marshal(v T) (variable.Literal, error) {
+	s, err := m.marshal(v)
+	return variable.Literal{String: s}, err
+}
+
+func (m funcMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
+	return m.unmarshal(v.String)
+}
@@ -0,0 +1,281 @@
+package ferrite_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// color is an example of an application-defined type that does not implement
+// encoding.TextMarshaler or encoding.TextUnmarshaler.
+type color struct {
+	R, G, B uint8
+}
+
+func marshalColor(c color) (string, error) {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B), nil
+}
+
+func unmarshalColor(s string) (color, error) {
+	var c color
+	if _, err := fmt.Sscanf(strings.ToLower(s), "#%02x%02x%02x", &c.R, &c.G, &c.B); err != nil {
+		return color{}, errors.New("expected a hexadecimal color such as #ff8800")
+	}
+	return c, nil
+}
+
+var _ = Describe("type CustomBuilder", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Custom[netip.Addr]("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Custom[netip.Addr]("FERRITE_CUSTOM", "").Optional()
+		}).To(PanicWith("specification for FERRITE_CUSTOM is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the type can not be marshaled", func() {
+		Expect(func() {
+			Custom[color]("FERRITE_CUSTOM", "<desc>").Required()
+		}).To(PanicWith("ferrite_test.color does not implement encoding.TextMarshaler and encoding.TextUnmarshaler, use WithMarshaler() to supply marshaling functions"))
+	})
+
+	It("panics if the marshaling functions are nil", func() {
+		Expect(func() {
+			Custom[color]("FERRITE_CUSTOM", "<desc>").WithMarshaler(nil, unmarshalColor)
+		}).To(PanicWith("marshal and unmarshal functions must not be nil"))
+	})
+
+	It("panics if an example is invalid", func() {
+		Expect(func() {
+			Custom[color]("FERRITE_CUSTOM", "<desc>").
+				WithMarshaler(marshalColor, unmarshalColor).
+				WithConstraint("**MUST NOT** be black", func(c color) bool { return c != color{} }).
+				WithExample(color{}, "black").
+				Required()
+		}).To(PanicWith("specification for FERRITE_CUSTOM is invalid: example value: **MUST NOT** be black"))
+	})
+
+	When("the type implements encoding.TextMarshaler and encoding.TextUnmarshaler", func() {
+		When("the methods have value receivers", func() {
+			It("uses the methods to unmarshal the value", func() {
+				os.Setenv("FERRITE_CUSTOM", "192.168.0.1")
+
+				v := Custom[netip.Addr]("FERRITE_CUSTOM", "<desc>").
+					Required().
+					Value()
+
+				Expect(v).To(Equal(netip.MustParseAddr("192.168.0.1")))
+			})
+
+			It("panics if the value is invalid", func() {
+				os.Setenv("FERRITE_CUSTOM", "192.168.0")
+
+				Expect(func() {
+					Custom[netip.Addr]("FERRITE_CUSTOM", "<desc>").
+						Required().
+						Value()
+				}).To(PanicWith(
+					`value of FERRITE_CUSTOM (192.168.0) is invalid: ParseAddr("192.168.0"): IPv4 address too short`,
+				))
+			})
+		})
+
+		When("the type is a pointer", func() {
+			It("allocates a new value to unmarshal into", func() {
+				os.Setenv("FERRITE_CUSTOM", "123456789012345678901234567890")
+
+				v := Custom[*big.Int]("FERRITE_CUSTOM", "<desc>").
+					Required().
+					Value()
+
+				Expect(v.String()).To(Equal("123456789012345678901234567890"))
+			})
+		})
+	})
+
+	When("explicit marshaling functions are provided", func() {
+		var builder *CustomBuilder[color]
+
+		BeforeEach(func() {
+			builder = Custom[color]("FERRITE_CUSTOM", "<desc>").
+				WithMarshaler(marshalColor, unmarshalColor)
+		})
+
+		It("uses the functions to unmarshal the value", func() {
+			os.Setenv("FERRITE_CUSTOM", "#FF8800")
+
+			v := builder.
+				Required().
+				Value()
+
+			Expect(v).To(Equal(color{0xff, 0x88, 0x00}))
+		})
+
+		It("panics if the value is invalid", func() {
+			os.Setenv("FERRITE_CUSTOM", "orange")
+
+			Expect(func() {
+				builder.
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_CUSTOM (orange) is invalid: expected a hexadecimal color such as #ff8800`,
+			))
+		})
+
+		It("takes precedence over encoding.TextUnmarshaler", func() {
+			os.Setenv("FERRITE_CUSTOM", "localhost")
+
+			v := Custom[netip.Addr]("FERRITE_CUSTOM_ADDR", "<desc>").
+				WithMarshaler(
+					func(v netip.Addr) (string, error) {
+						if v.IsLoopback() {
+							return "localhost", nil
+						}
+						return v.String(), nil
+					},
+					func(s string) (netip.Addr, error) {
+						if s == "localhost" {
+							return netip.MustParseAddr("127.0.0.1"), nil
+						}
+						return netip.ParseAddr(s)
+					},
+				).
+				WithDefault(netip.MustParseAddr("::1")).
+				Required()
+
+			Expect(v.Value()).To(Equal(netip.MustParseAddr("::1")))
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				It("returns the default", func() {
+					v := builder.
+						WithDefault(color{0, 0, 0xff}).
+						Required().
+						Value()
+
+					Expect(v).To(Equal(color{0, 0, 0xff}))
+				})
+			})
+
+			When("the variable is optional", func() {
+				It("returns with ok == false", func() {
+					_, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeFalse())
+				})
+			})
+		})
+
+		When("there is a constraint", func() {
+			It("panics if the value does not satisfy the constraint", func() {
+				os.Setenv("FERRITE_CUSTOM", "#000000")
+
+				Expect(func() {
+					builder.
+						WithConstraint("**MUST NOT** be black", func(c color) bool { return c != color{} }).
+						Required().
+						Value()
+				}).To(PanicWith(
+					`value of FERRITE_CUSTOM ('#000000') is invalid: **MUST NOT** be black`,
+				))
+			})
+		})
+	})
+})
+
+func ExampleCustom_textUnmarshaler() {
+	defer example()()
+
+	v := ferrite.
+		Custom[netip.Addr]("FERRITE_CUSTOM", "example custom variable").
+		Required()
+
+	os.Setenv("FERRITE_CUSTOM", "2001:db8::1")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2001:db8::1
+}
+
+func ExampleCustom_marshaler() {
+	defer example()()
+
+	type Color struct {
+		R, G, B uint8
+	}
+
+	v := ferrite.
+		Custom[Color]("FERRITE_CUSTOM", "example custom variable").
+		WithMarshaler(
+			func(c Color) (string, error) {
+				return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B), nil
+			},
+			func(s string) (Color, error) {
+				var c Color
+				_, err := fmt.Sscanf(s, "#%02x%02x%02x", &c.R, &c.G, &c.B)
+				return c, err
+			},
+		).
+		WithDocumentation(
+			"Color syntax",
+			"Colors are specified as a `#` followed by six hexadecimal digits, such as `#ff8800`.",
+		).
+		WithExample(Color{0xff, 0x88, 0x00}, "orange").
+		Required()
+
+	os.Setenv("FERRITE_CUSTOM", "#ff8800")
+	ferrite.Init()
+
+	fmt.Printf("value is %+v\n", v.Value())
+
+	// Output:
+	// value is {R:255 G:136 B:0}
+}
+
+func ExampleCustom_validation() {
+	defer example()()
+
+	os.Setenv("FERRITE_CUSTOM", "#FF8800")
+	ferrite.
+		Custom[color]("FERRITE_CUSTOM", "example custom variable").
+		WithMarshaler(marshalColor, unmarshalColor).
+		Required()
+
+	os.Setenv("FERRITE_CUSTOM_INVALID", "orange")
+	ferrite.
+		Custom[color]("FERRITE_CUSTOM_INVALID", "example invalid custom variable").
+		WithMarshaler(marshalColor, unmarshalColor).
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_CUSTOM          example custom variable            <string>    ✓ set to '#FF8800', equivalent to '#ff8800'
+	//  ❯ FERRITE_CUSTOM_INVALID  example invalid custom variable    <string>    ✗ set to orange, expected a hexadecimal color such as #ff8800
+	//
+	// <process exited with error code 1>
+}
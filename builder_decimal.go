@@ -0,0 +1,290 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Decimal configures an environment variable as an arbitrary-precision decimal
+// number, such as a monetary amount.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// Values are represented exactly, without the rounding errors inherent to
+// floating-point types. The value returned by the variable is shared, and must
+// not be modified.
+func Decimal(name, desc string) *DecimalBuilder {
+	b := &DecimalBuilder{
+		schema: variable.TypedBigNumeric[*big.Rat]{
+			Marshaler: decimalMarshaler{Scale: -1},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// DecimalBuilder builds a specification for an arbitrary-precision decimal
+// variable.
+type DecimalBuilder struct {
+	schema    variable.TypedBigNumeric[*big.Rat]
+	builder   variable.TypedSpecBuilder[*big.Rat]
+	completed bool
+}
+
+var _ isBuilderOf[*big.Rat, *DecimalBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. It panics if
+// v is not a valid decimal, such as "12.50".
+func (b *DecimalBuilder) WithDefault(v string) *DecimalBuilder {
+	b.builder.Default(mustParseDecimal(v))
+	return b
+}
+
+// WithMinimum sets the minimum acceptable value of the variable.
+//
+// It panics if v is not a valid decimal, such as "0.01".
+func (b *DecimalBuilder) WithMinimum(v string) *DecimalBuilder {
+	b.schema.NativeMin = maybe.Some(mustParseDecimal(v))
+	return b
+}
+
+// WithMaximum sets the maximum acceptable value of the variable.
+//
+// It panics if v is not a valid decimal, such as "1000.00".
+func (b *DecimalBuilder) WithMaximum(v string) *DecimalBuilder {
+	b.schema.NativeMax = maybe.Some(mustParseDecimal(v))
+	return b
+}
+
+// WithScale sets the number of digits after the decimal point.
+//
+// Values with more significant digits after the decimal point are invalid.
+// Values with fewer digits are accepted, and are padded with trailing zeros
+// when displayed. For example, with a scale of 2, "12.5" is accepted and
+// displayed as "12.50", but "12.505" is invalid.
+func (b *DecimalBuilder) WithScale(n int) *DecimalBuilder {
+	if n < 0 {
+		panic(fmt.Sprintf("scale (%d) must not be negative", n))
+	}
+
+	b.schema.Marshaler = decimalMarshaler{Scale: n}
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *DecimalBuilder) Required(options ...RequiredOption) Required[*big.Rat] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *DecimalBuilder) Optional(options ...OptionalOption) Optional[*big.Rat] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *DecimalBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*big.Rat] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *DecimalBuilder) element() (variable.TypedSchema[*big.Rat], *variable.TypedSpecBuilder[*big.Rat]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds documentation and an example that depend on the builder's
+// options.
+//
+// It has no effect if it has already been called.
+func (b *DecimalBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	b.addExample()
+
+	doc := b.builder.Documentation().
+		Summary("Decimal syntax").
+		Paragraph(
+			"Decimal numbers are specified using decimal (base-10) notation with an **OPTIONAL** fractional part, such as `12.50`.",
+			"A leading positive sign (`+`) is **OPTIONAL**.",
+			"A leading negative sign (`-`) is **REQUIRED** in order to specify a negative value.",
+			"Scientific notation is not accepted.",
+		).
+		Format()
+
+	if m := b.schema.Marshaler.(decimalMarshaler); m.Scale == 0 {
+		doc = doc.
+			Paragraph(
+				"The value **MUST NOT** have any significant digits after the decimal point.",
+			).
+			Format()
+	} else if m.Scale > 0 {
+		doc = doc.
+			Paragraph(
+				"The value **MUST NOT** have more than %d significant digits after the decimal point.",
+				"Values are displayed with exactly %d digits after the decimal point.",
+			).
+			Format(m.Scale, m.Scale)
+	}
+
+	doc.
+		Paragraph(
+			"Internally, %s is represented exactly using an arbitrary-precision rational type (`*big.Rat`),",
+			"so values are not subject to floating-point rounding errors.",
+		).
+		Format(documentationSubject(b.builder.Peek().Name())).
+		Done()
+}
+
+// addExample adds an example of a typical value within the permitted range,
+// rounded to the builder's scale.
+func (b *DecimalBuilder) addExample() {
+	offset := big.NewRat(1000, 1)
+	min, hasMin := b.schema.NativeMin.Get()
+	max, hasMax := b.schema.NativeMax.Get()
+
+	var example *big.Rat
+	switch {
+	case hasMin && hasMax:
+		example = new(big.Rat).Add(min, max)
+		example.Quo(example, big.NewRat(2, 1))
+	case hasMin:
+		example = new(big.Rat).Add(min, offset)
+	case hasMax:
+		example = new(big.Rat).Sub(max, offset)
+	default:
+		example = big.NewRat(123456, 100)
+	}
+
+	if m := b.schema.Marshaler.(decimalMarshaler); m.Scale >= 0 {
+		example, _ = new(big.Rat).SetString(example.FloatString(m.Scale))
+	}
+
+	b.builder.NonNormativeExample(example, "")
+}
+
+// mustParseDecimal parses a decimal number, or panics if it is invalid.
+func mustParseDecimal(v string) *big.Rat {
+	n, err := decimalMarshaler{Scale: -1}.Unmarshal(variable.Literal{String: v})
+	if err != nil {
+		panic(fmt.Sprintf("invalid decimal (%s): %s", v, err))
+	}
+	return n
+}
+
+type decimalMarshaler struct {
+	// Scale is the number of digits after the decimal point. If it is negative
+	// the scale is not fixed, and values are displayed using as few digits as
+	// are necessary to represent them exactly.
+	Scale int
+}
+
+func (m decimalMarshaler) Marshal(v *big.Rat) (variable.Literal, error) {
+	digits, ok := decimalDigits(v)
+	if !ok {
+		return variable.Literal{}, fmt.Errorf("%s can not be represented exactly as a decimal", v.RatString())
+	}
+
+	if m.Scale >= 0 {
+		if digits > m.Scale {
+			return variable.Literal{}, m.scaleError()
+		}
+		digits = m.Scale
+	}
+
+	s := v.FloatString(digits)
+	if v.Sign() >= 0 {
+		s = "+" + s
+	}
+
+	return variable.Literal{
+		String: s,
+	}, nil
+}
+
+func (m decimalMarshaler) Unmarshal(v variable.Literal) (*big.Rat, error) {
+	s := v.String
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+
+	if !isPlainDecimal(s) {
+		return nil, errors.New("unrecognized decimal syntax")
+	}
+
+	n, ok := new(big.Rat).SetString(v.String)
+	if !ok {
+		return nil, errors.New("unrecognized decimal syntax")
+	}
+
+	if m.Scale >= 0 {
+		if digits, _ := decimalDigits(n); digits > m.Scale {
+			return nil, m.scaleError()
+		}
+	}
+
+	return n, nil
+}
+
+func (m decimalMarshaler) scaleError() error {
+	if m.Scale == 0 {
+		return errors.New("expected a whole number")
+	}
+
+	return fmt.Errorf(
+		"too many digits after the decimal point, expected no more than %d",
+		m.Scale,
+	)
+}
+
+// decimalDigits returns the minimum number of digits after the decimal point
+// required to represent v exactly.
+//
+// ok is false if v has no exact decimal representation, such as 1/3.
+func decimalDigits(v *big.Rat) (n int, ok bool) {
+	d := new(big.Int).Set(v.Denom())
+
+	var twos, fives int
+	for d.Bit(0) == 0 {
+		d.Rsh(d, 1)
+		twos++
+	}
+
+	five := big.NewInt(5)
+	rem := new(big.Int)
+	for {
+		q, r := new(big.Int).QuoRem(d, five, rem)
+		if r.Sign() != 0 {
+			break
+		}
+		d = q
+		fives++
+	}
+
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+
+	if twos > fives {
+		return twos, true
+	}
+
+	return fives, true
+}
@@ -0,0 +1,352 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type DecimalBuilder", func() {
+	var builder *DecimalBuilder
+
+	BeforeEach(func() {
+		builder = Decimal("FERRITE_DECIMAL", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Decimal("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Decimal("FERRITE_DECIMAL", "").Optional()
+		}).To(PanicWith("specification for FERRITE_DECIMAL is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the scale is negative", func() {
+		Expect(func() {
+			builder.WithScale(-1)
+		}).To(PanicWith("scale (-1) must not be negative"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		Expect(func() {
+			builder.WithDefault("1e3")
+		}).To(PanicWith("invalid decimal (1e3): unrecognized decimal syntax"))
+	})
+
+	It("panics if the default value does not fit the scale", func() {
+		Expect(func() {
+			builder.
+				WithScale(2).
+				WithDefault("0.125").
+				Required()
+		}).To(PanicWith("specification for FERRITE_DECIMAL is invalid: default value: too many digits after the decimal point, expected no more than 2"))
+	})
+
+	It("panics if the limits do not fit the scale", func() {
+		Expect(func() {
+			builder.
+				WithScale(0).
+				WithMinimum("0.5").
+				Required()
+		}).To(PanicWith("specification for FERRITE_DECIMAL is invalid: minimum value: expected a whole number"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid decimal", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the exact value",
+					func(value, expect string) {
+						os.Setenv("FERRITE_DECIMAL", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.RatString()).To(Equal(expect))
+					},
+					Entry("whole number", "12", "12"),
+					Entry("fractional", "12.5", "25/2"),
+					Entry("not exactly representable as a float", "0.1", "1/10"),
+					Entry("negative", "-0.001", "-1/1000"),
+					Entry("explicit positive sign", "+1.25", "5/4"),
+					Entry("no leading digit", ".5", "1/2"),
+					Entry(
+						"more precision than float64",
+						"12345678901234567890.123456789",
+						"12345678901234567890123456789/1000000000",
+					),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value string) {
+						os.Setenv("FERRITE_DECIMAL", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							fmt.Sprintf(
+								`value of FERRITE_DECIMAL (%s) is invalid: unrecognized decimal syntax`,
+								value,
+							),
+						))
+					},
+					Entry("not a number", "ten"),
+					Entry("scientific notation", "1e3"),
+					Entry("fraction", "1/3"),
+					Entry("multiple decimal points", "1.2.3"),
+					Entry("sign only", "-"),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("9.99").
+							Required().
+							Value()
+
+						Expect(v.Cmp(big.NewRat(999, 100))).To(Equal(0))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_DECIMAL is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the scale is fixed", func() {
+		It("accepts values with fewer digits after the decimal point", func() {
+			os.Setenv("FERRITE_DECIMAL", "12.5")
+
+			v := builder.
+				WithScale(2).
+				Required().
+				Value()
+
+			Expect(v.FloatString(2)).To(Equal("12.50"))
+		})
+
+		It("accepts insignificant trailing zeros", func() {
+			os.Setenv("FERRITE_DECIMAL", "12.5000")
+
+			v := builder.
+				WithScale(2).
+				Required().
+				Value()
+
+			Expect(v.FloatString(2)).To(Equal("12.50"))
+		})
+
+		It("panics if the value has too many digits after the decimal point", func() {
+			os.Setenv("FERRITE_DECIMAL", "12.505")
+
+			Expect(func() {
+				builder.
+					WithScale(2).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_DECIMAL (12.505) is invalid: too many digits after the decimal point, expected no more than 2`,
+			))
+		})
+
+		It("panics if the value is not a whole number when the scale is zero", func() {
+			os.Setenv("FERRITE_DECIMAL", "12.5")
+
+			Expect(func() {
+				builder.
+					WithScale(0).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_DECIMAL (12.5) is invalid: expected a whole number`,
+			))
+		})
+	})
+
+	When("the value is lower than the minimum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_DECIMAL", "0.009")
+
+				builder.
+					WithMinimum("0.01").
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_DECIMAL (0.009) is invalid: too low, expected +0.01 or greater`,
+			))
+		})
+	})
+
+	When("the value is greater than the maximum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_DECIMAL", "1000.01")
+
+				builder.
+					WithScale(2).
+					WithMinimum("0").
+					WithMaximum("1000").
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_DECIMAL (1000.01) is invalid: too high, expected between +0.00 and +1000.00`,
+			))
+		})
+	})
+})
+
+func ExampleDecimal_required() {
+	defer example()()
+
+	v := ferrite.
+		Decimal("FERRITE_DECIMAL", "example decimal variable").
+		Required()
+
+	os.Setenv("FERRITE_DECIMAL", "0.1")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value().FloatString(1))
+
+	// Output:
+	// value is 0.1
+}
+
+func ExampleDecimal_default() {
+	defer example()()
+
+	v := ferrite.
+		Decimal("FERRITE_DECIMAL", "example decimal variable").
+		WithDefault("-12.34").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value().FloatString(2))
+
+	// Output:
+	// value is -12.34
+}
+
+func ExampleDecimal_optional() {
+	defer example()()
+
+	v := ferrite.
+		Decimal("FERRITE_DECIMAL", "example decimal variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x.FloatString(2))
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleDecimal_scale() {
+	defer example()()
+
+	v := ferrite.
+		Decimal("FERRITE_DECIMAL", "example decimal variable").
+		WithScale(2).
+		WithMinimum("0.01").
+		WithMaximum("1000").
+		Required()
+
+	os.Setenv("FERRITE_DECIMAL", "12.5")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value().FloatString(2))
+
+	// Output:
+	// value is 12.50
+}
+
+func ExampleDecimal_validation() {
+	defer example()()
+
+	os.Setenv("FERRITE_DECIMAL", "12.5")
+	ferrite.
+		Decimal("FERRITE_DECIMAL", "example decimal variable").
+		WithScale(2).
+		Required()
+
+	os.Setenv("FERRITE_DECIMAL_LIMITS", "1000.01")
+	ferrite.
+		Decimal("FERRITE_DECIMAL_LIMITS", "example decimal variable with limits").
+		WithMaximum("1000").
+		Required()
+
+	os.Setenv("FERRITE_DECIMAL_INVALID", "1e3")
+	ferrite.
+		Decimal("FERRITE_DECIMAL_INVALID", "example invalid decimal variable").
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_DECIMAL          example decimal variable                <decimal>    ✓ set to 12.5, equivalent to +12.50
+	//  ❯ FERRITE_DECIMAL_INVALID  example invalid decimal variable        <decimal>    ✗ set to 1e3, expected decimal
+	//  ❯ FERRITE_DECIMAL_LIMITS   example decimal variable with limits    ... +1000    ✗ set to 1000.01, too high, expected +1000 or less
+	//
+	// <process exited with error code 1>
+}
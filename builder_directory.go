@@ -0,0 +1,127 @@
+package ferrite
+
+import (
+	"path/filepath"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Directory configures an environment variable as a directory path.
+//
+// By default the file system is not checked. Use WithMustExist(),
+// WithReadAccess(), WithWriteAccess() or WithCreateIfMissing() to check the
+// directory when the environment is validated.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Directory(name, desc string) *DirectoryBuilder {
+	b := &DirectoryBuilder{}
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.NonNormativeExample("/path/to/directory", "an absolute directory path")
+	b.builder.NonNormativeExample("./path/to/directory", "a relative directory path")
+	return b
+}
+
+// DirectoryBuilder builds a specification for a directory variable.
+type DirectoryBuilder struct {
+	schema    variable.TypedString[DirectoryName]
+	builder   variable.TypedSpecBuilder[DirectoryName]
+	path      pathRequirements
+	completed bool
+}
+
+var _ isBuilderOf[DirectoryName, *DirectoryBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *DirectoryBuilder) WithDefault(v string) *DirectoryBuilder {
+	b.builder.Default(DirectoryName(v))
+	return b
+}
+
+// WithMustExist requires the directory to exist.
+//
+// The file system is checked when the environment is validated, not when the
+// variable is built.
+func (b *DirectoryBuilder) WithMustExist() *DirectoryBuilder {
+	b.path.MustExist = true
+	return b
+}
+
+// WithReadAccess requires the directory to exist and for its content to be
+// readable by the current process.
+func (b *DirectoryBuilder) WithReadAccess() *DirectoryBuilder {
+	b.path.Read = true
+	return b
+}
+
+// WithWriteAccess requires the directory to exist and for the current process
+// to be able to create files within it.
+//
+// The file system is not modified when checking that the directory is
+// writable.
+func (b *DirectoryBuilder) WithWriteAccess() *DirectoryBuilder {
+	b.path.Write = true
+	return b
+}
+
+// WithCreateIfMissing creates the directory, including any missing parent
+// directories, if it does not exist.
+//
+// The directory is created once, when the environment is validated, after all
+// of the other requirements have been checked. Any failure to create the
+// directory is reported as a validation error.
+func (b *DirectoryBuilder) WithCreateIfMissing() *DirectoryBuilder {
+	b.path.Create = true
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *DirectoryBuilder) Required(options ...RequiredOption) Required[DirectoryName] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *DirectoryBuilder) Optional(options ...OptionalOption) Optional[DirectoryName] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *DirectoryBuilder) Deprecated(options ...DeprecatedOption) Deprecated[DirectoryName] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *DirectoryBuilder) element() (variable.TypedSchema[DirectoryName], *variable.TypedSpecBuilder[DirectoryName]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints and documentation that depend on the builder's
+// options.
+//
+// It has no effect if it has already been called.
+func (b *DirectoryBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	b.schema.Requirement = "**MUST** be the path to a directory"
+	addPathRequirements(&b.builder, b.path, true)
+}
+
+// DirectoryName is the name of a directory.
+type DirectoryName string
+
+// Join returns the path to a file or directory within this directory.
+func (n DirectoryName) Join(elem ...string) string {
+	return filepath.Join(append([]string{string(n)}, elem...)...)
+}
@@ -0,0 +1,365 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type DirectoryBuilder", func() {
+	var builder *DirectoryBuilder
+
+	BeforeEach(func() {
+		builder = Directory("FERRITE_DIRECTORY", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Directory("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Directory("FERRITE_DIRECTORY", "").Optional()
+		}).To(PanicWith("specification for FERRITE_DIRECTORY is invalid: variable description must not be empty"))
+	})
+
+	It("does not check the file system when the variable is built", func() {
+		Expect(func() {
+			builder.
+				WithMustExist().
+				WithDefault("testdata/does-not-exist").
+				Optional()
+		}).NotTo(Panic())
+	})
+
+	When("the variable is required", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the value", func() {
+					os.Setenv("FERRITE_DIRECTORY", "/path/to/directory")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(Equal(DirectoryName("/path/to/directory")))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("/path/to/directory").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(DirectoryName("/path/to/directory")))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_DIRECTORY is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the file system is checked", func() {
+		DescribeTable(
+			"it returns the value if the directory meets the requirements",
+			func(configure func(*DirectoryBuilder) *DirectoryBuilder) {
+				dir := GinkgoT().TempDir()
+				os.Setenv("FERRITE_DIRECTORY", dir)
+
+				v := configure(builder).
+					Required().
+					Value()
+
+				Expect(v).To(Equal(DirectoryName(dir)))
+			},
+			Entry("must exist", (*DirectoryBuilder).WithMustExist),
+			Entry("read access", (*DirectoryBuilder).WithReadAccess),
+			Entry("write access", (*DirectoryBuilder).WithWriteAccess),
+		)
+
+		It("does not leave any files behind when checking for write access", func() {
+			dir := GinkgoT().TempDir()
+			os.Setenv("FERRITE_DIRECTORY", dir)
+
+			builder.
+				WithWriteAccess().
+				Required().
+				Value()
+
+			entries, err := os.ReadDir(dir)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		DescribeTable(
+			"it panics if the directory does not meet the requirements",
+			func(value string, configure func(*DirectoryBuilder) *DirectoryBuilder, expect string) {
+				os.Setenv("FERRITE_DIRECTORY", value)
+
+				Expect(func() {
+					configure(builder).
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"directory does not exist",
+				"testdata/does-not-exist",
+				(*DirectoryBuilder).WithMustExist,
+				`value of FERRITE_DIRECTORY (testdata/does-not-exist) is invalid: directory does not exist`,
+			),
+			Entry(
+				"path is a file",
+				"testdata/hello.txt",
+				(*DirectoryBuilder).WithReadAccess,
+				`value of FERRITE_DIRECTORY (testdata/hello.txt) is invalid: path is not a directory`,
+			),
+		)
+
+		It("panics if the directory is not writable", func() {
+			if os.Geteuid() == 0 {
+				Skip("file permissions are not enforced for the root user")
+			}
+
+			dir := GinkgoT().TempDir()
+			Expect(os.Chmod(dir, 0o500)).To(Succeed())
+			DeferCleanup(func() { os.Chmod(dir, 0o700) })
+
+			os.Setenv("FERRITE_DIRECTORY", dir)
+
+			Expect(func() {
+				builder.
+					WithWriteAccess().
+					Required().
+					Value()
+			}).To(PanicWith(
+				fmt.Sprintf("value of FERRITE_DIRECTORY (%s) is invalid: directory is not writable: permission denied", dir),
+			))
+		})
+
+		It("panics if the default value does not meet the requirements", func() {
+			Expect(func() {
+				builder.
+					WithMustExist().
+					WithDefault("testdata/does-not-exist").
+					Required().
+					Value()
+			}).To(PanicWith(
+				`default value of FERRITE_DIRECTORY (testdata/does-not-exist) is invalid: directory does not exist`,
+			))
+		})
+
+		It("creates the directory and its parents if it is missing", func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "parent", "child")
+			os.Setenv("FERRITE_DIRECTORY", dir)
+
+			v := builder.
+				WithCreateIfMissing().
+				WithWriteAccess().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(DirectoryName(dir)))
+			Expect(dir).To(BeADirectory())
+		})
+
+		It("creates the default directory if it is missing", func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "default")
+
+			builder.
+				WithCreateIfMissing().
+				WithDefault(dir).
+				Required().
+				Value()
+
+			Expect(dir).To(BeADirectory())
+		})
+
+		It("creates the directory once, when the environment is validated", func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "parent", "child")
+			os.Setenv("FERRITE_DIRECTORY", dir)
+
+			v := builder.
+				WithCreateIfMissing().
+				WithWriteAccess().
+				Required()
+
+			for _, v := range variable.DefaultRegistry.Variables() {
+				Expect(v.Error()).ShouldNot(HaveOccurred())
+			}
+
+			Expect(dir).To(BeADirectory())
+			Expect(os.Remove(dir)).To(Succeed())
+
+			v.Value()
+			Expect(dir).NotTo(BeAnExistingFile())
+		})
+	})
+})
+
+var _ = Describe("type DirectoryName", func() {
+	Describe("func Join()", func() {
+		It("returns the path to an entry within the directory", func() {
+			n := DirectoryName("testdata")
+			Expect(n.Join("hello.txt")).To(Equal(filepath.Join("testdata", "hello.txt")))
+		})
+	})
+})
+
+func ExampleDirectory_required() {
+	defer example()()
+
+	v := ferrite.
+		Directory("FERRITE_DIRECTORY", "example directory variable").
+		Required()
+
+	os.Setenv("FERRITE_DIRECTORY", "testdata")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is testdata
+}
+
+func ExampleDirectory_default() {
+	defer example()()
+
+	v := ferrite.
+		Directory("FERRITE_DIRECTORY", "example directory variable").
+		WithDefault("testdata").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is testdata
+}
+
+func ExampleDirectory_optional() {
+	defer example()()
+
+	v := ferrite.
+		Directory("FERRITE_DIRECTORY", "example directory variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleDirectory_mustExist() {
+	defer example()()
+
+	v := ferrite.
+		Directory("FERRITE_DIRECTORY", "example directory variable").
+		WithMustExist().
+		WithReadAccess().
+		Required()
+
+	os.Setenv("FERRITE_DIRECTORY", "testdata")
+	ferrite.Init()
+
+	fmt.Println("file is", v.Value().Join("hello.txt"))
+
+	// Output:
+	// file is testdata/hello.txt
+}
+
+func ExampleDirectory_invalidDefault() {
+	defer example()()
+
+	ferrite.
+		Directory("FERRITE_DIRECTORY", "example directory variable").
+		WithMustExist().
+		WithDefault("testdata/does-not-exist").
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_DIRECTORY  example directory variable  [ <string> ] = testdata/does-not-exist  ✗ default value is invalid, directory does not exist
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleDirectory_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_DIRECTORY", "testdata")
+	v := ferrite.
+		Directory("FERRITE_DIRECTORY", "example directory variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_DIRECTORY  example directory variable  [ <string> ]  ⚠ deprecated variable set to testdata
+	//
+	// value is testdata
+}
@@ -3,6 +3,7 @@ package ferrite
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -16,6 +17,11 @@ import (
 // human-readable description of the environment variable.
 //
 // Durations have a minimum value of 1 nanosecond by default.
+//
+// By default, durations are specified using the syntax accepted by
+// time.ParseDuration(). Use WithDayAndWeekUnits(), WithISO8601Syntax() and
+// WithNever() to accept additional forms. The canonical representation of the
+// value always uses the time.ParseDuration() syntax.
 func Duration(name, desc string) *DurationBuilder {
 	b := &DurationBuilder{
 		schema: variable.TypedNumeric[time.Duration]{
@@ -26,22 +32,16 @@ func Duration(name, desc string) *DurationBuilder {
 
 	b.builder.Name(name)
 	b.builder.Description(desc)
-	b.builder.Documentation().
-		Summary("Duration syntax").
-		Paragraph(
-			"Durations are specified as a sequence of decimal numbers, each with an optional fraction and a unit suffix, such as `300ms`, `-1.5h` or `2h45m`.",
-			"Supported time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`.",
-		).
-		Format().
-		Done()
 
 	return b
 }
 
 // DurationBuilder builds a specification for a duration variable.
 type DurationBuilder struct {
-	schema  variable.TypedNumeric[time.Duration]
-	builder variable.TypedSpecBuilder[time.Duration]
+	schema    variable.TypedNumeric[time.Duration]
+	builder   variable.TypedSpecBuilder[time.Duration]
+	marshaler durationMarshaler
+	completed bool
 }
 
 var _ isBuilderOf[time.Duration, *DurationBuilder]
@@ -66,27 +66,126 @@ func (b *DurationBuilder) WithMaximum(v time.Duration) *DurationBuilder {
 	return b
 }
 
+// WithDayAndWeekUnits accepts the "d" (day) and "w" (week) units in addition
+// to the units supported by time.ParseDuration(), such as "30d" or "1w2d".
+//
+// A day is always 24 hours and a week is always 7 days, regardless of daylight
+// saving time transitions.
+func (b *DurationBuilder) WithDayAndWeekUnits() *DurationBuilder {
+	b.marshaler.DayAndWeekUnits = true
+	return b
+}
+
+// WithISO8601Syntax accepts durations in ISO 8601 format, such as "PT30M" or
+// "P1DT2H".
+//
+// Durations that specify years or months are rejected, as they do not have a
+// fixed length.
+func (b *DurationBuilder) WithISO8601Syntax() *DurationBuilder {
+	b.marshaler.ISO8601 = true
+	return b
+}
+
+// WithNever accepts the special values "never" and "infinite", which are
+// treated as the duration v.
+//
+// v is typically a very large duration, such as math.MaxInt64, or a value
+// that the application treats as "disabled". It is subject to the same
+// minimum and maximum limits as any other value. The canonical representation
+// of v is "never".
+func (b *DurationBuilder) WithNever(v time.Duration) *DurationBuilder {
+	b.marshaler.Never = maybe.Some(v)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *DurationBuilder) Required(options ...RequiredOption) Required[time.Duration] {
+	b.complete()
 	return required(b.schema, &b.builder, options...)
 }
 
 // Optional completes the build process and registers an optional variable with
 // Ferrite's validation system.
 func (b *DurationBuilder) Optional(options ...OptionalOption) Optional[time.Duration] {
+	b.complete()
 	return optional(b.schema, &b.builder, options...)
 }
 
 // Deprecated completes the build process and registers a deprecated variable
 // with Ferrite's validation system.
 func (b *DurationBuilder) Deprecated(options ...DeprecatedOption) Deprecated[time.Duration] {
+	b.complete()
 	return deprecated(b.schema, &b.builder, options...)
 }
 
-type durationMarshaler struct{}
+func (b *DurationBuilder) element() (variable.TypedSchema[time.Duration], *variable.TypedSpecBuilder[time.Duration]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the examples and documentation that depend on the builder's
+// options.
+//
+// It has no effect if it has already been called.
+func (b *DurationBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	b.schema.Marshaler = b.marshaler
+
+	units := "Supported time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`."
+	if b.marshaler.DayAndWeekUnits {
+		units = "Supported time units are `ns`, `us` (or `µs`), `ms`, `s`, `m`, `h`, `d` and `w`, where a day is always 24 hours and a week is always 7 days."
+	}
+
+	doc := b.builder.Documentation().
+		Summary("Duration syntax").
+		Paragraph(
+			"Durations are specified as a sequence of decimal numbers, each with an optional fraction and a unit suffix, such as `300ms`, `-1.5h` or `2h45m`.",
+			units,
+		).
+		Format()
+
+	if b.marshaler.ISO8601 {
+		doc = doc.
+			Paragraph(
+				"Durations may also be specified in ISO 8601 format, such as `PT30M` or `P1DT12H`.",
+				"Years and months are not supported, as they do not have a fixed length.",
+			).
+			Format()
+	}
+
+	if v, ok := b.marshaler.Never.Get(); ok {
+		b.builder.NormativeExample(v, "a duration that never elapses")
+
+		doc = doc.
+			Paragraph(
+				"The special value `never`, or its alias `infinite`, may be used to specify a duration that never elapses.",
+			).
+			Format()
+	}
+
+	doc.Done()
+}
+
+// durationMarshaler marshals and unmarshals durations in the syntax accepted by
+// time.ParseDuration(), and any additional syntaxes that are enabled.
+type durationMarshaler struct {
+	DayAndWeekUnits bool
+	ISO8601         bool
+	Never           maybe.Value[time.Duration]
+}
+
+func (m durationMarshaler) Marshal(v time.Duration) (variable.Literal, error) {
+	if n, ok := m.Never.Get(); ok && v == n {
+		return variable.Literal{
+			String: "never",
+		}, nil
+	}
 
-func (durationMarshaler) Marshal(v time.Duration) (variable.Literal, error) {
 	runes := []rune(v.String())
 	zeroes := false
 
@@ -116,8 +215,43 @@ loop:
 	}, nil
 }
 
-func (durationMarshaler) Unmarshal(v variable.Literal) (time.Duration, error) {
-	d, err := time.ParseDuration(strings.ReplaceAll(v.String, " ", ""))
+func (m durationMarshaler) Unmarshal(v variable.Literal) (time.Duration, error) {
+	s := strings.ReplaceAll(v.String, " ", "")
+
+	if n, ok := m.Never.Get(); ok {
+		if strings.EqualFold(s, "never") || strings.EqualFold(s, "infinite") {
+			return n, nil
+		}
+	}
+
+	if m.ISO8601 && isISO8601Duration(s) {
+		return parseISO8601Duration(s)
+	}
+
+	if m.DayAndWeekUnits {
+		s, extra, err := extractDayAndWeekUnits(s)
+		if err != nil {
+			return 0, err
+		}
+
+		if s == "" {
+			return extra, nil
+		}
+
+		d, err := parseGoDuration(s)
+		if err != nil {
+			return 0, err
+		}
+
+		return addDurations(d, extra)
+	}
+
+	return parseGoDuration(s)
+}
+
+// parseGoDuration parses s using time.ParseDuration().
+func parseGoDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
 	if err == nil {
 		return d, nil
 	}
@@ -130,9 +264,179 @@ func (durationMarshaler) Unmarshal(v variable.Literal) (time.Duration, error) {
 	return 0, errors.New(
 		strings.Replace(
 			strings.TrimPrefix(m, "time: "),
-			fmt.Sprintf(` in duration %q`, v.String),
+			fmt.Sprintf(` in duration %q`, s),
 			"",
 			1,
 		),
 	)
 }
+
+// extractDayAndWeekUnits removes the components of s that use the "d" and "w"
+// units, returning the remainder of s and the total duration of the removed
+// components.
+func extractDayAndWeekUnits(s string) (string, time.Duration, error) {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+
+	var (
+		rest  strings.Builder
+		total time.Duration
+	)
+
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.'
+		})
+		if i == -1 {
+			i = len(s)
+		}
+
+		j := i + strings.IndexAny(s[i:], "0123456789.")
+		if j < i {
+			j = len(s)
+		}
+
+		num, unit := s[:i], s[i:j]
+		s = s[j:]
+
+		var hours time.Duration
+		switch unit {
+		case "d":
+			hours = 24
+		case "w":
+			hours = 7 * 24
+		default:
+			rest.WriteString(num)
+			rest.WriteString(unit)
+			continue
+		}
+
+		d, err := time.ParseDuration(num + "h")
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid number of %s (%s)", durationUnitNames[unit], num)
+		}
+
+		if d > maxDuration/hours {
+			return "", 0, errors.New("duration is too large")
+		}
+
+		total, err = addDurations(total, d*hours)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
+	if sign == "-" {
+		total = -total
+	}
+
+	if rest.Len() == 0 {
+		return "", total, nil
+	}
+
+	return sign + rest.String(), total, nil
+}
+
+// durationUnitNames is a map of the additional duration units to their names.
+var durationUnitNames = map[string]string{
+	"d": "days",
+	"w": "weeks",
+}
+
+// maxDuration is the largest representable duration.
+const maxDuration = time.Duration(1<<63 - 1)
+
+// addDurations returns a + b, or an error if the result overflows.
+func addDurations(a, b time.Duration) (time.Duration, error) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return 0, errors.New("duration is too large")
+	}
+	return c, nil
+}
+
+// iso8601DurationPattern is a regular expression that matches an ISO 8601
+// duration. Each numeric component may have a fraction, using either a period
+// or a comma as the decimal separator.
+var iso8601DurationPattern = regexp.MustCompile(
+	`(?i)^([-+])?P` +
+		`(?:(\d+(?:[.,]\d+)?)Y)?` +
+		`(?:(\d+(?:[.,]\d+)?)M)?` +
+		`(?:(\d+(?:[.,]\d+)?)W)?` +
+		`(?:(\d+(?:[.,]\d+)?)D)?` +
+		`(?:T` +
+		`(?:(\d+(?:[.,]\d+)?)H)?` +
+		`(?:(\d+(?:[.,]\d+)?)M)?` +
+		`(?:(\d+(?:[.,]\d+)?)S)?` +
+		`)?$`,
+)
+
+// isISO8601Duration returns true if s appears to be an ISO 8601 duration.
+func isISO8601Duration(s string) bool {
+	s = strings.TrimLeft(s, "-+")
+	return strings.HasPrefix(s, "P") || strings.HasPrefix(s, "p")
+}
+
+// parseISO8601Duration parses an ISO 8601 duration.
+func parseISO8601Duration(s string) (time.Duration, error) {
+	m := iso8601DurationPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, errors.New("invalid ISO 8601 duration, expected a duration such as PT30M or P1DT12H")
+	}
+
+	if m[2] != "" || m[3] != "" {
+		return 0, errors.New("ISO 8601 durations must not specify years or months, as they do not have a fixed length")
+	}
+
+	components := []struct {
+		Value string
+		Unit  time.Duration
+	}{
+		{m[4], 7 * 24 * time.Hour},
+		{m[5], 24 * time.Hour},
+		{m[6], time.Hour},
+		{m[7], time.Minute},
+		{m[8], time.Second},
+	}
+
+	var (
+		total time.Duration
+		found bool
+	)
+
+	for _, c := range components {
+		if c.Value == "" {
+			continue
+		}
+		found = true
+
+		// Scale the number by the unit's length in seconds, then parse it as a
+		// number of seconds to avoid floating-point rounding errors.
+		d, err := time.ParseDuration(strings.Replace(c.Value, ",", ".", 1) + "s")
+		if err != nil {
+			return 0, err
+		}
+
+		scale := c.Unit / time.Second
+		if d > maxDuration/scale {
+			return 0, errors.New("duration is too large")
+		}
+
+		total, err = addDurations(total, d*scale)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if !found {
+		return 0, errors.New("ISO 8601 durations must specify at least one component")
+	}
+
+	if m[1] == "-" {
+		total = -total
+	}
+
+	return total, nil
+}
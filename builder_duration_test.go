@@ -2,6 +2,7 @@ package ferrite_test
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"time"
 
@@ -221,6 +222,144 @@ var _ = Describe("type DurationBuilder", func() {
 			))
 		})
 	})
+
+	When("day and week units are enabled", func() {
+		DescribeTable(
+			"it accepts durations that use the additional units",
+			func(value string, expect time.Duration) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				v := builder.
+					WithDayAndWeekUnits().
+					WithMinimum(math.MinInt64).
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expect))
+			},
+			Entry("days", "30d", 30*24*time.Hour),
+			Entry("weeks", "2w", 14*24*time.Hour),
+			Entry("fractional days", "1.5d", 36*time.Hour),
+			Entry("mixed units", "1w2d3h30m", 9*24*time.Hour+3*time.Hour+30*time.Minute),
+			Entry("negative", "-1d12h", -36*time.Hour),
+			Entry("standard units only", "90m", 90*time.Minute),
+		)
+
+		It("panics if the duration is too large", func() {
+			os.Setenv("FERRITE_DURATION", "1000000w")
+
+			Expect(func() {
+				builder.
+					WithDayAndWeekUnits().
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_DURATION (1000000w) is invalid: duration is too large`,
+			))
+		})
+
+		It("does not accept the additional units unless they are enabled", func() {
+			os.Setenv("FERRITE_DURATION", "30d")
+
+			Expect(func() {
+				builder.
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_DURATION (30d) is invalid: unknown unit "d"`,
+			))
+		})
+	})
+
+	When("ISO 8601 syntax is enabled", func() {
+		DescribeTable(
+			"it accepts ISO 8601 durations",
+			func(value string, expect time.Duration) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				v := builder.
+					WithISO8601Syntax().
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expect))
+			},
+			Entry("time components", "PT1H30M", 90*time.Minute),
+			Entry("date and time components", "P1DT2H", 26*time.Hour),
+			Entry("weeks", "P2W", 14*24*time.Hour),
+			Entry("fractional seconds", "PT0.5S", 500*time.Millisecond),
+			Entry("comma as decimal separator", "PT1,5M", 90*time.Second),
+			Entry("lowercase", "pt10s", 10*time.Second),
+			Entry("standard syntax", "10s", 10*time.Second),
+		)
+
+		DescribeTable(
+			"it panics if the duration is invalid",
+			func(value, expect string) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				Expect(func() {
+					builder.
+						WithISO8601Syntax().
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"years",
+				"P1Y",
+				`value of FERRITE_DURATION (P1Y) is invalid: ISO 8601 durations must not specify years or months, as they do not have a fixed length`,
+			),
+			Entry(
+				"no components",
+				"PT",
+				`value of FERRITE_DURATION (PT) is invalid: ISO 8601 durations must specify at least one component`,
+			),
+			Entry(
+				"malformed",
+				"P1H",
+				`value of FERRITE_DURATION (P1H) is invalid: invalid ISO 8601 duration, expected a duration such as PT30M or P1DT12H`,
+			),
+		)
+	})
+
+	When("the never value is enabled", func() {
+		DescribeTable(
+			"it accepts the special values",
+			func(value string) {
+				os.Setenv("FERRITE_DURATION", value)
+
+				v := builder.
+					WithNever(math.MaxInt64).
+					Required().
+					Value()
+
+				Expect(v).To(Equal(time.Duration(math.MaxInt64)))
+			},
+			Entry("never", "never"),
+			Entry("infinite", "infinite"),
+			Entry("uppercase", "NEVER"),
+		)
+
+		It("uses never as the canonical representation of the value", func() {
+			v := builder.
+				WithNever(math.MaxInt64).
+				WithDefault(math.MaxInt64).
+				Required()
+
+			Expect(v.Value()).To(Equal(time.Duration(math.MaxInt64)))
+		})
+
+		It("panics if the never value is less than the minimum limit", func() {
+			Expect(func() {
+				builder.
+					WithNever(0).
+					Required()
+			}).To(PanicWith(
+				`specification for FERRITE_DURATION is invalid: example value: too low, expected 1ns or greater`,
+			))
+		})
+	})
 })
 
 func ExampleDuration_required() {
@@ -292,6 +431,26 @@ func ExampleDuration_limits() {
 	// value is 0s
 }
 
+func ExampleDuration_extendedSyntax() {
+	defer example()()
+
+	v := ferrite.
+		Duration("FERRITE_DURATION", "example duration variable").
+		WithDayAndWeekUnits().
+		WithISO8601Syntax().
+		WithNever(0).
+		WithMinimum(0).
+		Required()
+
+	os.Setenv("FERRITE_DURATION", "P1W2D")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 216h0m0s
+}
+
 func ExampleDuration_deprecated() {
 	defer example()()
 
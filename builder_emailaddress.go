@@ -0,0 +1,252 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// EmailAddress configures an environment variable as an email address.
+//
+// The address may include a display name, such as "Alerts
+// <alerts@example.org>", unless WithoutDisplayName() is used.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func EmailAddress(name, desc string) *EmailAddressBuilder {
+	b := &EmailAddressBuilder{
+		schema: variable.TypedOther[*mail.Address]{
+			Marshaler: emailAddressMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// EmailAddressBuilder builds a specification for an email address variable.
+type EmailAddressBuilder struct {
+	schema        variable.TypedOther[*mail.Address]
+	builder       variable.TypedSpecBuilder[*mail.Address]
+	noDisplayName bool
+	domains       []string
+	completed     bool
+}
+
+var _ isBuilderOf[*mail.Address, *EmailAddressBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *EmailAddressBuilder) WithDefault(v string) *EmailAddressBuilder {
+	b.builder.Default(mustParseEmailAddress(v))
+	return b
+}
+
+// WithoutDisplayName forbids addresses that include a display name, such that
+// only the bare address (e.g. "alerts@example.org") is accepted.
+func (b *EmailAddressBuilder) WithoutDisplayName() *EmailAddressBuilder {
+	b.noDisplayName = true
+	return b
+}
+
+// WithAllowedDomains restricts the variable to addresses within the given
+// domains.
+//
+// Domains are compared case-insensitively. Subdomains of the allowed domains
+// are not permitted unless they are listed explicitly.
+func (b *EmailAddressBuilder) WithAllowedDomains(domain string, additional ...string) *EmailAddressBuilder {
+	for _, d := range append([]string{domain}, additional...) {
+		if d == "" {
+			panic("allowed domains must not be empty")
+		}
+		b.domains = append(b.domains, strings.ToLower(d))
+	}
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *EmailAddressBuilder) Required(options ...RequiredOption) Required[*mail.Address] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *EmailAddressBuilder) Optional(options ...OptionalOption) Optional[*mail.Address] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *EmailAddressBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*mail.Address] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *EmailAddressBuilder) element() (variable.TypedSchema[*mail.Address], *variable.TypedSpecBuilder[*mail.Address]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints, examples and documentation that depend on the
+// builder's options.
+//
+// It has no effect if it has already been called.
+func (b *EmailAddressBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	desc := "**MUST** be a valid email address"
+	if b.noDisplayName {
+		desc += " without a display name"
+	}
+
+	if len(b.domains) != 0 {
+		var quoted []string
+		for _, d := range b.domains {
+			quoted = append(quoted, "`"+d+"`")
+		}
+		desc += " within the " + inlineList(quoted) + " domain"
+		if len(b.domains) > 1 {
+			desc += "s"
+		}
+	}
+
+	b.builder.BuiltInConstraint(
+		desc,
+		func(v *mail.Address) variable.ConstraintError {
+			if b.noDisplayName && v.Name != "" {
+				return errors.New("address must not include a display name")
+			}
+
+			if len(b.domains) != 0 {
+				_, domain, _ := strings.Cut(v.Address, "@")
+				domain = strings.ToLower(domain)
+
+				for _, d := range b.domains {
+					if d == domain {
+						return nil
+					}
+				}
+
+				return fmt.Errorf(
+					"domain %s is not permitted, expected %s",
+					domain,
+					inlineList(b.domains),
+				)
+			}
+
+			return nil
+		},
+	)
+
+	domain := "example.org"
+	if len(b.domains) != 0 {
+		domain = b.domains[0]
+	}
+
+	b.builder.NormativeExample(
+		&mail.Address{Address: "alerts@" + domain},
+		"an email address",
+	)
+
+	if !b.noDisplayName {
+		b.builder.NormativeExample(
+			&mail.Address{Name: "Alerts", Address: "alerts@" + domain},
+			"an email address with a display name",
+		)
+	}
+
+	doc := b.builder.Documentation().
+		Summary("Email address syntax").
+		Paragraph(
+			"An email address consists of a local part and a domain separated by an `@` symbol,",
+			"such as `alerts@%s`.",
+		).
+		Format(domain)
+
+	if !b.noDisplayName {
+		doc = doc.
+			Paragraph(
+				"The address may be preceded by a display name,",
+				"in which case the address itself is enclosed in angle brackets,",
+				"such as `Alerts <alerts@%s>`.",
+				"Display names that contain punctuation must be enclosed in double quotes.",
+			).
+			Format(domain)
+	}
+
+	doc.Done()
+}
+
+type emailAddressMarshaler struct{}
+
+func (emailAddressMarshaler) Marshal(v *mail.Address) (variable.Literal, error) {
+	if v.Name == "" {
+		return variable.Literal{
+			String: v.Address,
+		}, nil
+	}
+
+	// Only quote the display name if necessary. The mail.Address.String()
+	// method always quotes the name, even if it is a simple phrase.
+	if isSimpleDisplayName(v.Name) {
+		return variable.Literal{
+			String: v.Name + " <" + v.Address + ">",
+		}, nil
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+// isSimpleDisplayName returns true if n can be used as a display name without
+// quoting or encoding.
+func isSimpleDisplayName(n string) bool {
+	if strings.TrimSpace(n) != n || strings.Contains(n, "  ") {
+		return false
+	}
+
+	for _, r := range n {
+		switch {
+		case r >= 'a' && r <= 'z':
+		case r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9':
+		case r == ' ':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func (emailAddressMarshaler) Unmarshal(v variable.Literal) (*mail.Address, error) {
+	addr, err := mail.ParseAddress(v.String)
+	if err != nil {
+		return nil, errors.New(
+			strings.ReplaceAll(err.Error(), "mail: ", ""),
+		)
+	}
+
+	return addr, nil
+}
+
+func mustParseEmailAddress(v string) *mail.Address {
+	addr, err := mail.ParseAddress(v)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
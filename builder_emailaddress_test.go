@@ -0,0 +1,328 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net/mail"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type EmailAddressBuilder", func() {
+	var builder *EmailAddressBuilder
+
+	BeforeEach(func() {
+		builder = EmailAddress("FERRITE_EMAIL_ADDRESS", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			EmailAddress("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			EmailAddress("FERRITE_EMAIL_ADDRESS", "").Optional()
+		}).To(PanicWith("specification for FERRITE_EMAIL_ADDRESS is invalid: variable description must not be empty"))
+	})
+
+	It("panics if an allowed domain is empty", func() {
+		Expect(func() {
+			builder.WithAllowedDomains("example.org", "")
+		}).To(PanicWith("allowed domains must not be empty"))
+	})
+
+	It("panics if the default value is not within the allowed domains", func() {
+		Expect(func() {
+			builder.
+				WithAllowedDomains("example.org").
+				WithDefault("alerts@example.com").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_EMAIL_ADDRESS is invalid: default value: domain example.com is not permitted, expected example.org"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid address", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect *mail.Address) {
+						os.Setenv("FERRITE_EMAIL_ADDRESS", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry(
+						"bare address",
+						"alerts@example.org",
+						&mail.Address{Address: "alerts@example.org"},
+					),
+					Entry(
+						"display name",
+						"Alerts <alerts@example.org>",
+						&mail.Address{Name: "Alerts", Address: "alerts@example.org"},
+					),
+					Entry(
+						"quoted display name",
+						`"Ops, Team" <ops@example.org>`,
+						&mail.Address{Name: "Ops, Team", Address: "ops@example.org"},
+					),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_EMAIL_ADDRESS", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing @ symbol",
+						"alerts",
+						`value of FERRITE_EMAIL_ADDRESS (alerts) is invalid: missing '@' or angle-addr`,
+					),
+					Entry(
+						"unclosed angle brackets",
+						"Alerts <alerts@example.org",
+						`value of FERRITE_EMAIL_ADDRESS ('Alerts <alerts@example.org') is invalid: unclosed angle-addr`,
+					),
+					Entry(
+						"multiple addresses",
+						"a@example.org, b@example.org",
+						`value of FERRITE_EMAIL_ADDRESS ('a@example.org, b@example.org') is invalid: expected single address, got ", b@example.org"`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("Alerts <alerts@example.org>").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(&mail.Address{Name: "Alerts", Address: "alerts@example.org"}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_EMAIL_ADDRESS is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("display names are forbidden", func() {
+		It("accepts a bare address", func() {
+			os.Setenv("FERRITE_EMAIL_ADDRESS", "alerts@example.org")
+
+			v := builder.
+				WithoutDisplayName().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(&mail.Address{Address: "alerts@example.org"}))
+		})
+
+		It("panics if the address has a display name", func() {
+			os.Setenv("FERRITE_EMAIL_ADDRESS", "Alerts <alerts@example.org>")
+
+			Expect(func() {
+				builder.
+					WithoutDisplayName().
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_EMAIL_ADDRESS ('Alerts <alerts@example.org>') is invalid: address must not include a display name`,
+			))
+		})
+	})
+
+	When("the domain is restricted", func() {
+		BeforeEach(func() {
+			builder.WithAllowedDomains("example.org", "EXAMPLE.NET")
+		})
+
+		DescribeTable(
+			"it accepts addresses within the allowed domains",
+			func(value string) {
+				os.Setenv("FERRITE_EMAIL_ADDRESS", value)
+
+				v := builder.
+					Required().
+					Value()
+
+				Expect(v.Address).To(Equal(value))
+			},
+			Entry("first domain", "alerts@example.org"),
+			Entry("second domain", "alerts@example.net"),
+			Entry("different case", "alerts@Example.ORG"),
+		)
+
+		DescribeTable(
+			"it panics if the address is not within the allowed domains",
+			func(value, expect string) {
+				os.Setenv("FERRITE_EMAIL_ADDRESS", value)
+
+				Expect(func() {
+					builder.
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"different domain",
+				"alerts@example.com",
+				`value of FERRITE_EMAIL_ADDRESS (alerts@example.com) is invalid: domain example.com is not permitted, expected example.org or example.net`,
+			),
+			Entry(
+				"subdomain",
+				"alerts@mail.example.org",
+				`value of FERRITE_EMAIL_ADDRESS (alerts@mail.example.org) is invalid: domain mail.example.org is not permitted, expected example.org or example.net`,
+			),
+		)
+	})
+})
+
+func ExampleEmailAddress_required() {
+	defer example()()
+
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL_ADDRESS", "example email address variable").
+		Required()
+
+	os.Setenv("FERRITE_EMAIL_ADDRESS", "Alerts <alerts@example.org>")
+	ferrite.Init()
+
+	fmt.Println("name is", v.Value().Name)
+	fmt.Println("address is", v.Value().Address)
+
+	// Output:
+	// name is Alerts
+	// address is alerts@example.org
+}
+
+func ExampleEmailAddress_default() {
+	defer example()()
+
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL_ADDRESS", "example email address variable").
+		WithDefault("alerts@example.org").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("address is", v.Value().Address)
+
+	// Output:
+	// address is alerts@example.org
+}
+
+func ExampleEmailAddress_optional() {
+	defer example()()
+
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL_ADDRESS", "example email address variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("address is", x.Address)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleEmailAddress_restricted() {
+	defer example()()
+
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL_ADDRESS", "example email address variable").
+		WithoutDisplayName().
+		WithAllowedDomains("example.org").
+		Required()
+
+	os.Setenv("FERRITE_EMAIL_ADDRESS", "alerts@example.org")
+	ferrite.Init()
+
+	fmt.Println("address is", v.Value().Address)
+
+	// Output:
+	// address is alerts@example.org
+}
+
+func ExampleEmailAddress_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_EMAIL_ADDRESS", `"Alerts" <alerts@example.org>`)
+	v := ferrite.
+		EmailAddress("FERRITE_EMAIL_ADDRESS", "example email address variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("address is", x.Address)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_EMAIL_ADDRESS  example email address variable  [ <string> ]  ⚠ deprecated variable set to '"Alerts" <alerts@example.org>', equivalent to 'Alerts <alerts@example.org>'
+	//
+	// address is alerts@example.org
+}
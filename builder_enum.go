@@ -102,3 +102,7 @@ func (b *EnumBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
 func (b *EnumBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
 	return deprecated(b.schema, &b.builder, options...)
 }
+
+func (b *EnumBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
@@ -1,9 +1,14 @@
 package ferrite
 
 import (
+	"errors"
+	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/dogmatiq/ferrite/variable"
 )
@@ -23,8 +28,10 @@ func File(name, desc string) *FileBuilder {
 
 // FileBuilder builds a specification for a boolean value.
 type FileBuilder struct {
-	schema  variable.TypedString[FileName]
-	builder variable.TypedSpecBuilder[FileName]
+	schema    variable.TypedString[FileName]
+	builder   variable.TypedSpecBuilder[FileName]
+	path      pathRequirements
+	completed bool
 }
 
 var _ isBuilderOf[FileName, *FileBuilder]
@@ -37,24 +44,82 @@ func (b *FileBuilder) WithDefault(v string) *FileBuilder {
 	return b
 }
 
+// WithMustExist requires the file to exist and to be a regular file (or a
+// symbolic link to a regular file).
+//
+// The file system is checked when the environment is validated, not when the
+// variable is built.
+func (b *FileBuilder) WithMustExist() *FileBuilder {
+	b.path.MustExist = true
+	return b
+}
+
+// WithReadAccess requires the file to exist and to be readable by the current
+// process.
+func (b *FileBuilder) WithReadAccess() *FileBuilder {
+	b.path.Read = true
+	return b
+}
+
+// WithWriteAccess requires the file to exist and to be writable by the current
+// process.
+//
+// The file system is not modified when checking that the file is writable.
+func (b *FileBuilder) WithWriteAccess() *FileBuilder {
+	b.path.Write = true
+	return b
+}
+
+// WithCreateIfMissing creates an empty file if the file does not exist.
+//
+// The file's parent directory must already exist. The file is created once,
+// when the environment is validated, after all of the other requirements have
+// been checked. Any failure to create the file is reported as a validation
+// error.
+func (b *FileBuilder) WithCreateIfMissing() *FileBuilder {
+	b.path.Create = true
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *FileBuilder) Required(options ...RequiredOption) Required[FileName] {
+	b.complete()
 	return required(b.schema, &b.builder, options...)
 }
 
 // Optional completes the build process and registers an optional variable with
 // Ferrite's validation system.
 func (b *FileBuilder) Optional(options ...OptionalOption) Optional[FileName] {
+	b.complete()
 	return optional(b.schema, &b.builder, options...)
 }
 
 // Deprecated completes the build process and registers a deprecated variable
 // with Ferrite's validation system.
 func (b *FileBuilder) Deprecated(options ...DeprecatedOption) Deprecated[FileName] {
+	b.complete()
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *FileBuilder) element() (variable.TypedSchema[FileName], *variable.TypedSpecBuilder[FileName]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints and documentation that depend on the builder's
+// options.
+//
+// It has no effect if it has already been called.
+func (b *FileBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	addPathRequirements(&b.builder, b.path, false)
+}
+
 // FileName is the name of a file.
 type FileName string
 
@@ -73,3 +138,224 @@ func (n FileName) ReadString() (string, error) {
 	data, err := n.ReadBytes()
 	return string(data), err
 }
+
+// pathRequirements is a set of requirements on the file system entry referred
+// to by file and directory variables.
+type pathRequirements struct {
+	MustExist bool
+	Read      bool
+	Write     bool
+	Create    bool
+}
+
+// addPathRequirements adds a runtime constraint and documentation to b that
+// enforce the given requirements. isDir indicates whether the path refers to a
+// directory or a regular file.
+func addPathRequirements[T ~string](
+	b *variable.TypedSpecBuilder[T],
+	r pathRequirements,
+	isDir bool,
+) {
+	if !r.MustExist && !r.Read && !r.Write && !r.Create {
+		return
+	}
+
+	noun := "regular file"
+	if isDir {
+		noun = "directory"
+	}
+
+	desc := "**MUST** be the path to an existing " + noun
+	if r.Create {
+		desc = "**MUST** be the path to a " + noun
+	}
+
+	var access []string
+	if r.Read {
+		access = append(access, "readable")
+	}
+	if r.Write {
+		access = append(access, "writable")
+	}
+	if len(access) != 0 {
+		desc += " that is " + strings.Join(access, " and ") + " by the process"
+	}
+
+	b.BuiltInRuntimeConstraint(
+		desc,
+		func(v T) variable.ConstraintError {
+			if err := r.check(string(v), isDir); err != nil {
+				return err
+			}
+
+			if r.Create {
+				return r.create(string(v), isDir)
+			}
+
+			return nil
+		},
+	)
+
+	if r.Create {
+		doc := b.Documentation().Summary("Automatic creation")
+
+		if isDir {
+			doc.
+				Paragraph(
+					"If the directory does not exist it is created automatically,",
+					"along with any missing parent directories.",
+				).
+				Format().
+				Done()
+		} else {
+			doc.
+				Paragraph(
+					"If the file does not exist an empty file is created automatically.",
+					"The file's parent directory **MUST** already exist.",
+				).
+				Format().
+				Done()
+		}
+	}
+}
+
+// check returns an error if the file system entry at path does not meet the
+// requirements.
+//
+// The file system is never modified. If the entry does not exist and r.Create
+// is true, it checks that the entry could be created instead; it is created by
+// create().
+func (r pathRequirements) check(path string, isDir bool) error {
+	noun := pathNoun(isDir)
+
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		if !r.Create {
+			return fmt.Errorf("%s does not exist", noun)
+		}
+
+		if err := checkCreateAccess(path, isDir); err != nil {
+			return fmt.Errorf("unable to create %s: %w", noun, unwrapPathError(err))
+		}
+
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to access %s: %w", noun, unwrapPathError(err))
+	}
+
+	if isDir && !info.IsDir() {
+		return errors.New("path is not a directory")
+	} else if !isDir && !info.Mode().IsRegular() {
+		return errors.New("path is not a regular file")
+	}
+
+	if r.Read {
+		if err := checkReadAccess(path, isDir); err != nil {
+			return fmt.Errorf("%s is not readable: %w", noun, unwrapPathError(err))
+		}
+	}
+
+	if r.Write {
+		if err := checkWriteAccess(path, isDir); err != nil {
+			return fmt.Errorf("%s is not writable: %w", noun, unwrapPathError(err))
+		}
+	}
+
+	return nil
+}
+
+// create creates an empty file or directory at path, if it does not already
+// exist.
+//
+// It is called when the environment is validated, once the entry has been
+// checked against the requirements.
+func (r pathRequirements) create(path string, isDir bool) error {
+	if err := createPath(path, isDir); err != nil {
+		return fmt.Errorf("unable to create %s: %w", pathNoun(isDir), unwrapPathError(err))
+	}
+	return nil
+}
+
+// createPath creates an empty file or directory at path, if it does not
+// already exist.
+func createPath(path string, isDir bool) error {
+	if isDir {
+		return os.MkdirAll(path, 0o777)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o666)
+	if errors.Is(err, fs.ErrExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// pathNoun returns the noun used to refer to a file system entry in error
+// messages.
+func pathNoun(isDir bool) string {
+	if isDir {
+		return "directory"
+	}
+	return "file"
+}
+
+// checkCreateAccess returns an error if the current process can not create a
+// file or directory at path, without modifying the file system.
+//
+// A file's parent directory must already exist. A directory is created along
+// with any missing parent directories, so its nearest existing ancestor is
+// checked instead.
+func checkCreateAccess(path string, isDir bool) error {
+	parent := filepath.Dir(path)
+
+	for {
+		info, err := os.Stat(parent)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("%s is not a directory", parent)
+			}
+			return checkWriteAccess(parent, true)
+		}
+
+		if !isDir || !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		next := filepath.Dir(parent)
+		if next == parent {
+			return err
+		}
+		parent = next
+	}
+}
+
+// checkReadAccess returns an error if the current process can not read the
+// file or directory at path.
+func checkReadAccess(path string, isDir bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if isDir {
+		if _, err := f.Readdirnames(1); err != nil && err != io.EOF {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unwrapPathError returns the underlying cause of err if it is an
+// *fs.PathError, such that the path is not repeated in the error message.
+func unwrapPathError(err error) error {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Err
+	}
+	return err
+}
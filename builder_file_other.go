@@ -0,0 +1,35 @@
+//go:build !unix
+
+package ferrite
+
+import (
+	"io/fs"
+	"os"
+)
+
+// checkWriteAccess returns an error if the current process can not write to
+// the file or directory at path.
+//
+// Files are opened for writing without being modified. Directories are checked
+// using their permission bits, as there is no portable way to check that a file
+// could be created within a directory without creating one.
+func checkWriteAccess(path string, isDir bool) error {
+	if !isDir {
+		f, err := os.OpenFile(path, os.O_WRONLY, 0)
+		if err != nil {
+			return err
+		}
+		return f.Close()
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	if info.Mode().Perm()&0o200 == 0 {
+		return &fs.PathError{Op: "access", Path: path, Err: fs.ErrPermission}
+	}
+
+	return nil
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/dogmatiq/ferrite"
 	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/variable"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -125,6 +127,142 @@ var _ = Describe("type FileBuilder", func() {
 	})
 })
 
+var _ = Describe("type FileBuilder (file system checks)", func() {
+	var builder *FileBuilder
+
+	BeforeEach(func() {
+		builder = File("FERRITE_FILE", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("does not check the file system when the variable is built", func() {
+		Expect(func() {
+			builder.
+				WithMustExist().
+				WithDefault("testdata/does-not-exist").
+				Optional()
+		}).NotTo(Panic())
+	})
+
+	DescribeTable(
+		"it returns the value if the file meets the requirements",
+		func(configure func(*FileBuilder) *FileBuilder) {
+			os.Setenv("FERRITE_FILE", "testdata/hello.txt")
+
+			v := configure(builder).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(FileName("testdata/hello.txt")))
+		},
+		Entry("must exist", (*FileBuilder).WithMustExist),
+		Entry("read access", (*FileBuilder).WithReadAccess),
+		Entry("write access", (*FileBuilder).WithWriteAccess),
+	)
+
+	DescribeTable(
+		"it panics if the file does not meet the requirements",
+		func(value string, configure func(*FileBuilder) *FileBuilder, expect string) {
+			os.Setenv("FERRITE_FILE", value)
+
+			Expect(func() {
+				configure(builder).
+					Required().
+					Value()
+			}).To(PanicWith(expect))
+		},
+		Entry(
+			"file does not exist",
+			"testdata/does-not-exist",
+			(*FileBuilder).WithMustExist,
+			`value of FERRITE_FILE (testdata/does-not-exist) is invalid: file does not exist`,
+		),
+		Entry(
+			"path is a directory",
+			"testdata",
+			(*FileBuilder).WithReadAccess,
+			`value of FERRITE_FILE (testdata) is invalid: path is not a regular file`,
+		),
+	)
+
+	It("panics if the default value does not meet the requirements", func() {
+		Expect(func() {
+			builder.
+				WithMustExist().
+				WithDefault("testdata/does-not-exist").
+				Required().
+				Value()
+		}).To(PanicWith(
+			`default value of FERRITE_FILE (testdata/does-not-exist) is invalid: file does not exist`,
+		))
+	})
+
+	It("creates the file if it is missing", func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "file.txt")
+		os.Setenv("FERRITE_FILE", filename)
+
+		v := builder.
+			WithCreateIfMissing().
+			WithWriteAccess().
+			Required().
+			Value()
+
+		Expect(v).To(Equal(FileName(filename)))
+		Expect(filename).To(BeARegularFile())
+	})
+
+	It("creates the file once, when the environment is validated", func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "file.txt")
+		os.Setenv("FERRITE_FILE", filename)
+
+		v := builder.
+			WithCreateIfMissing().
+			Required()
+
+		for _, v := range variable.DefaultRegistry.Variables() {
+			Expect(v.Error()).ShouldNot(HaveOccurred())
+		}
+
+		Expect(filename).To(BeARegularFile())
+		Expect(os.Remove(filename)).To(Succeed())
+
+		v.Value()
+		Expect(filename).NotTo(BeAnExistingFile())
+	})
+
+	It("reports a failure to create the file when the environment is validated", func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "does-not-exist", "file.txt")
+		os.Setenv("FERRITE_FILE", filename)
+
+		builder.
+			WithCreateIfMissing().
+			Required()
+
+		for _, v := range variable.DefaultRegistry.Variables() {
+			Expect(v.Error()).To(MatchError(
+				fmt.Sprintf("value of FERRITE_FILE (%s) is invalid: unable to create file: no such file or directory", filename),
+			))
+		}
+	})
+
+	It("panics if the file can not be created", func() {
+		filename := filepath.Join(GinkgoT().TempDir(), "does-not-exist", "file.txt")
+		os.Setenv("FERRITE_FILE", filename)
+
+		Expect(func() {
+			builder.
+				WithCreateIfMissing().
+				Required().
+				Value()
+		}).To(PanicWith(
+			fmt.Sprintf("value of FERRITE_FILE (%s) is invalid: unable to create file: no such file or directory", filename),
+		))
+	})
+})
+
 var _ = Describe("type FileName", func() {
 	var filename = FileName("testdata/hello.txt")
 
@@ -0,0 +1,27 @@
+//go:build unix
+
+package ferrite
+
+import (
+	"io/fs"
+
+	"golang.org/x/sys/unix"
+)
+
+// checkWriteAccess returns an error if the current process can not write to
+// the file or directory at path.
+//
+// It uses access(2), such that the file system is not modified.
+func checkWriteAccess(path string, isDir bool) error {
+	mode := uint32(unix.W_OK)
+	if isDir {
+		// Creating a file within a directory also requires search permission.
+		mode |= unix.X_OK
+	}
+
+	if err := unix.Access(path, mode); err != nil {
+		return &fs.PathError{Op: "access", Path: path, Err: err}
+	}
+
+	return nil
+}
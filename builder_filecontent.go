@@ -0,0 +1,283 @@
+package ferrite
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// FileContent configures an environment variable as the name of a file, such
+// that the variable's value is the content of the file.
+//
+// The file is read once, when the environment is validated. Any changes made
+// to the file after it is read are not reflected in the variable's value.
+//
+// The file must be no larger than 1MiB by default. Use WithMaximumSize() to
+// change this limit.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func FileContent(name, desc string) *FileContentBuilder[[]byte] {
+	return FileContentAs[[]byte](name, desc)
+}
+
+// FileContentAs configures an environment variable as the name of a file, such
+// that the variable's value is the content of the file, represented as type T.
+//
+// The file is read once, when the environment is validated. Any changes made
+// to the file after it is read are not reflected in the variable's value.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func FileContentAs[T ~[]byte | ~string](name, desc string) *FileContentBuilder[T] {
+	b := &FileContentBuilder[T]{
+		maxSize: 1 << 20,
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.NonNormativeExample("/path/to/file", "an absolute file path")
+	b.builder.NonNormativeExample("./path/to/file", "a relative file path")
+
+	return b
+}
+
+// FileContentBuilder builds a specification for a variable that refers to a
+// file, the content of which is used as the variable's value.
+type FileContentBuilder[T ~[]byte | ~string] struct {
+	schema  variable.TypedString[FileName]
+	builder variable.TypedSpecBuilder[FileName]
+	maxSize uint64
+	trim    bool
+
+	// content is the content of the file, as read when the variable's value
+	// was resolved.
+	content   []byte
+	completed bool
+}
+
+var _ isBuilderOf[[]byte, *FileContentBuilder[[]byte]]
+
+// WithDefault sets the name of a file to read when the environment variable is
+// undefined or empty.
+func (b *FileContentBuilder[T]) WithDefault(v string) *FileContentBuilder[T] {
+	b.builder.Default(FileName(v))
+	return b
+}
+
+// WithMaximumSize sets the maximum permitted size of the file, in bytes.
+func (b *FileContentBuilder[T]) WithMaximumSize(n uint64) *FileContentBuilder[T] {
+	if n == 0 {
+		panic("maximum size must be at least 1 byte")
+	}
+
+	b.maxSize = n
+	return b
+}
+
+// WithTrimTrailingNewline removes a single trailing newline ("\n" or "\r\n")
+// from the file's content, if present.
+//
+// This is useful for files that contain a single value, such as a password,
+// that may have been written by a text editor that adds a trailing newline.
+func (b *FileContentBuilder[T]) WithTrimTrailingNewline() *FileContentBuilder[T] {
+	b.trim = true
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *FileContentBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	b.complete()
+
+	s := required(b.schema, &b.builder, options...)
+
+	return requiredFunc[T]{
+		s.variables(),
+		func() (T, error) {
+			if _, err := s.resolve(); err != nil {
+				var zero T
+				return zero, err
+			}
+
+			return b.value(), nil
+		},
+	}
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *FileContentBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	b.complete()
+
+	s := optional(b.schema, &b.builder, options...)
+
+	return optionalFunc[T]{
+		s.variables(),
+		func() (T, bool, error) {
+			if _, ok, err := s.resolve(); !ok || err != nil {
+				var zero T
+				return zero, ok, err
+			}
+
+			return b.value(), true, nil
+		},
+	}
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *FileContentBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	b.complete()
+
+	s := deprecated(b.schema, &b.builder, options...)
+	v := s.variables()[0]
+
+	return deprecatedFunc[T]{
+		s.variables(),
+		func() (T, bool, error) {
+			var zero T
+
+			if err := v.Error(); err != nil {
+				return zero, false, err
+			}
+
+			if v.Availability() != variable.AvailabilityOK {
+				return zero, false, nil
+			}
+
+			return b.value(), true, nil
+		},
+	}
+}
+
+// element returns the schema and specification builder for the file's name.
+//
+// When used to describe the elements of a list or map, each file is read when
+// the environment is validated, but the elements are the names of the files,
+// not their content.
+func (b *FileContentBuilder[T]) element() (variable.TypedSchema[FileName], *variable.TypedSpecBuilder[FileName]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints and documentation that depend on the builder's
+// options.
+//
+// It has no effect if it has already been called.
+func (b *FileContentBuilder[T]) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	size := b.maxSizeString()
+
+	b.builder.BuiltInRuntimeConstraint(
+		fmt.Sprintf(
+			"**MUST** be the path to a readable regular file that is no larger than `%s`",
+			size,
+		),
+		func(n FileName) variable.ConstraintError {
+			data, err := b.read(n)
+			if err != nil {
+				return err
+			}
+
+			b.content = data
+			return nil
+		},
+	)
+
+	doc := b.builder.Documentation().
+		Summary("File content").
+		Paragraph(
+			"The file is read when the application starts,",
+			"and its content is used instead of the file name.",
+		).
+		Format()
+
+	if b.trim {
+		doc = doc.
+			Paragraph(
+				"A single trailing newline is removed from the file's content, if present.",
+			).
+			Format()
+	}
+
+	doc.Done()
+}
+
+// maxSizeString returns the maximum size of the file as a human-readable
+// string.
+func (b *FileContentBuilder[T]) maxSizeString() string {
+	size, err := byteSizeMarshaler{}.Marshal(b.maxSize)
+	if err != nil {
+		panic(err)
+	}
+	return size.String
+}
+
+// value returns a copy of the file's content, so that the caller may modify
+// the returned value without affecting subsequent calls.
+func (b *FileContentBuilder[T]) value() T {
+	return T(append([]byte(nil), b.content...))
+}
+
+// read returns the content of the file n, or an error if it is not a readable
+// regular file that is no larger than the maximum size.
+//
+// It is called when the environment is validated.
+func (b *FileContentBuilder[T]) read(n FileName) ([]byte, error) {
+	if err := (pathRequirements{Read: true}).check(string(n), false); err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(string(n))
+	if err != nil {
+		return nil, fmt.Errorf("unable to access file: %w", unwrapPathError(err))
+	}
+
+	if uint64(info.Size()) > b.maxSize {
+		return nil, b.tooLarge()
+	}
+
+	f, err := os.Open(string(n))
+	if err != nil {
+		return nil, fmt.Errorf("file is not readable: %w", unwrapPathError(err))
+	}
+	defer f.Close()
+
+	// Read one more byte than the maximum size to detect files that have
+	// grown since they were checked above, taking care not to overflow the
+	// int64 limit.
+	limit := int64(math.MaxInt64)
+	if b.maxSize < math.MaxInt64 {
+		limit = int64(b.maxSize) + 1
+	}
+
+	data, err := io.ReadAll(io.LimitReader(f, limit))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file: %w", unwrapPathError(err))
+	}
+
+	if uint64(len(data)) > b.maxSize {
+		return nil, b.tooLarge()
+	}
+
+	if b.trim && bytes.HasSuffix(data, []byte("\n")) {
+		data = bytes.TrimSuffix(data[:len(data)-1], []byte("\r"))
+	}
+
+	return data, nil
+}
+
+// tooLarge returns the error that occurs when the file exceeds the maximum
+// size.
+func (b *FileContentBuilder[T]) tooLarge() error {
+	return fmt.Errorf("file is too large, expected %s or less", b.maxSizeString())
+}
@@ -0,0 +1,367 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type FileContentBuilder", func() {
+	var builder *FileContentBuilder[[]byte]
+
+	BeforeEach(func() {
+		builder = FileContent("FERRITE_FILE_CONTENT", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			FileContent("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			FileContent("FERRITE_FILE_CONTENT", "").Optional()
+		}).To(PanicWith("specification for FERRITE_FILE_CONTENT is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the maximum size is zero", func() {
+		Expect(func() {
+			builder.WithMaximumSize(0)
+		}).To(PanicWith("maximum size must be at least 1 byte"))
+	})
+
+	It("does not read the file when the variable is built", func() {
+		Expect(func() {
+			builder.
+				WithDefault("testdata/does-not-exist").
+				Optional()
+		}).NotTo(Panic())
+	})
+
+	When("the variable is required", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the content of the file", func() {
+					os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(Equal([]byte("Hello, world!\n")))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the content of the default file", func() {
+						v := builder.
+							WithDefault("testdata/hello.txt").
+							Required().
+							Value()
+
+						Expect(v).To(Equal([]byte("Hello, world!\n")))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_FILE_CONTENT is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the content of the file", func() {
+					os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+
+					v, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeTrue())
+					Expect(v).To(Equal([]byte("Hello, world!\n")))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is deprecated", func() {
+		Describe("func DeprecatedValue()", func() {
+			It("returns the content of the file", func() {
+				os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+
+				v, ok := builder.
+					Deprecated().
+					DeprecatedValue()
+
+				Expect(ok).To(BeTrue())
+				Expect(v).To(Equal([]byte("Hello, world!\n")))
+			})
+		})
+	})
+
+	It("returns a separate copy of the content each time the value is obtained", func() {
+		os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+
+		v := builder.Required()
+
+		a := v.Value()
+		a[0] = 'J'
+
+		Expect(v.Value()).To(Equal([]byte("Hello, world!\n")))
+	})
+
+	It("does not read the file again after the environment is validated", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "content")
+		Expect(os.WriteFile(file, []byte("<before>"), 0o600)).To(Succeed())
+		os.Setenv("FERRITE_FILE_CONTENT", file)
+
+		v := builder.Required()
+		Expect(v.Value()).To(Equal([]byte("<before>")))
+
+		Expect(os.WriteFile(file, []byte("<after>"), 0o600)).To(Succeed())
+		Expect(v.Value()).To(Equal([]byte("<before>")))
+
+		Expect(os.Remove(file)).To(Succeed())
+		Expect(v.Value()).To(Equal([]byte("<before>")))
+	})
+
+	It("accepts the largest possible maximum size", func() {
+		os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+
+		v := builder.
+			WithMaximumSize(math.MaxUint64).
+			Required().
+			Value()
+
+		Expect(v).To(Equal([]byte("Hello, world!\n")))
+	})
+
+	It("can be used to describe the elements of a list", func() {
+		os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt,testdata/does-not-exist")
+
+		Expect(func() {
+			ListOf[FileName]("FERRITE_FILE_CONTENT", "<desc>", builder).
+				Required().
+				Value()
+		}).To(PanicWith(
+			`value of FERRITE_FILE_CONTENT (testdata/hello.txt,testdata/does-not-exist) is invalid: element #2 (testdata/does-not-exist) is invalid: file does not exist`,
+		))
+	})
+
+	When("the trailing newline is trimmed", func() {
+		DescribeTable(
+			"it removes a single trailing newline",
+			func(content, expect string) {
+				file := filepath.Join(GinkgoT().TempDir(), "content")
+				Expect(os.WriteFile(file, []byte(content), 0o600)).To(Succeed())
+				os.Setenv("FERRITE_FILE_CONTENT", file)
+
+				v := builder.
+					WithTrimTrailingNewline().
+					Required().
+					Value()
+
+				Expect(string(v)).To(Equal(expect))
+			},
+			Entry("LF", "<content>\n", "<content>"),
+			Entry("CRLF", "<content>\r\n", "<content>"),
+			Entry("multiple newlines", "<content>\n\n", "<content>\n"),
+			Entry("no newline", "<content>", "<content>"),
+			Entry("carriage return only", "<content>\r", "<content>\r"),
+		)
+	})
+
+	When("the file can not be read", func() {
+		DescribeTable(
+			"it panics",
+			func(value string, expect string) {
+				os.Setenv("FERRITE_FILE_CONTENT", value)
+
+				Expect(func() {
+					builder.
+						WithMaximumSize(10).
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"file does not exist",
+				"testdata/does-not-exist",
+				`value of FERRITE_FILE_CONTENT (testdata/does-not-exist) is invalid: file does not exist`,
+			),
+			Entry(
+				"path is a directory",
+				"testdata",
+				`value of FERRITE_FILE_CONTENT (testdata) is invalid: path is not a regular file`,
+			),
+			Entry(
+				"file is too large",
+				"testdata/hello.txt",
+				`value of FERRITE_FILE_CONTENT (testdata/hello.txt) is invalid: file is too large, expected 10B or less`,
+			),
+		)
+
+		It("panics if the default file does not exist", func() {
+			Expect(func() {
+				builder.
+					WithDefault("testdata/does-not-exist").
+					Required().
+					Value()
+			}).To(PanicWith(
+				`default value of FERRITE_FILE_CONTENT (testdata/does-not-exist) is invalid: file does not exist`,
+			))
+		})
+	})
+})
+
+func ExampleFileContent_required() {
+	defer example()()
+
+	v := ferrite.
+		FileContent("FERRITE_FILE_CONTENT", "example file content variable").
+		Required()
+
+	os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+	ferrite.Init()
+
+	fmt.Printf("value is %q\n", v.Value())
+
+	// Output:
+	// value is "Hello, world!\n"
+}
+
+func ExampleFileContent_default() {
+	defer example()()
+
+	v := ferrite.
+		FileContent("FERRITE_FILE_CONTENT", "example file content variable").
+		WithDefault("testdata/hello.txt").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Printf("value is %q\n", v.Value())
+
+	// Output:
+	// value is "Hello, world!\n"
+}
+
+func ExampleFileContent_optional() {
+	defer example()()
+
+	v := ferrite.
+		FileContent("FERRITE_FILE_CONTENT", "example file content variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Printf("value is %q\n", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleFileContentAs() {
+	defer example()()
+
+	v := ferrite.
+		FileContentAs[string]("FERRITE_FILE_CONTENT", "example file content variable").
+		WithTrimTrailingNewline().
+		Required()
+
+	os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+	ferrite.Init()
+
+	fmt.Printf("value is %q\n", v.Value())
+
+	// Output:
+	// value is "Hello, world!"
+}
+
+func ExampleFileContent_invalid() {
+	defer example()()
+
+	ferrite.
+		FileContent("FERRITE_FILE_CONTENT", "example file content variable").
+		WithMaximumSize(10).
+		Required()
+
+	os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_FILE_CONTENT  example file content variable    <string>    ✗ set to testdata/hello.txt, file is too large, expected 10B or less
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleFileContent_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_FILE_CONTENT", "testdata/hello.txt")
+	v := ferrite.
+		FileContent("FERRITE_FILE_CONTENT", "example file content variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Printf("value is %q\n", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_FILE_CONTENT  example file content variable  [ <string> ]  ⚠ deprecated variable set to testdata/hello.txt
+	//
+	// value is "Hello, world!\n"
+}
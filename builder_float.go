@@ -43,12 +43,12 @@ func Float[T constraints.Float](name, desc string) *FloatBuilder[T] {
 		).
 		Format().
 		Paragraph(
-			"Internally, the `%s` variable is represented using a %d-bit floating point type (`%s`);",
+			"Internally, %s is represented using a %d-bit floating point type (`%s`);",
 			"any value that overflows this data-type is invalid.",
 			"Values are rounded to the nearest floating-point number using IEEE 754 unbiased rounding.",
 		).
 		Format(
-			name,
+			documentationSubject(name),
 			reflectx.BitSize[T](),
 			reflectx.KindOf[T](),
 		).
@@ -107,6 +107,10 @@ func (b *FloatBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T]
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *FloatBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
+
 type floatMarshaler[T constraints.Float] struct{}
 
 func (floatMarshaler[T]) Marshal(v T) (variable.Literal, error) {
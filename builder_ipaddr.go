@@ -0,0 +1,255 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// IPAddr configures an environment variable as an IP address.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// Both IPv4 and IPv6 addresses are accepted by default.
+func IPAddr(name, desc string) *IPAddrBuilder {
+	b := &IPAddrBuilder{
+		schema: variable.TypedOther[netip.Addr]{
+			Marshaler: ipAddrMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// IPAddrBuilder builds a specification for an IP address variable.
+type IPAddrBuilder struct {
+	schema    variable.TypedOther[netip.Addr]
+	builder   variable.TypedSpecBuilder[netip.Addr]
+	ip        ipRestrictions
+	completed bool
+}
+
+var _ isBuilderOf[netip.Addr, *IPAddrBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *IPAddrBuilder) WithDefault(v string) *IPAddrBuilder {
+	b.builder.Default(netip.MustParseAddr(v))
+	return b
+}
+
+// WithIPv4Only restricts the variable to IPv4 addresses.
+func (b *IPAddrBuilder) WithIPv4Only() *IPAddrBuilder {
+	b.ip.Family = 4
+	return b
+}
+
+// WithIPv6Only restricts the variable to IPv6 addresses.
+func (b *IPAddrBuilder) WithIPv6Only() *IPAddrBuilder {
+	b.ip.Family = 6
+	return b
+}
+
+// WithoutLoopback forbids loopback addresses, such as 127.0.0.1 and ::1.
+func (b *IPAddrBuilder) WithoutLoopback() *IPAddrBuilder {
+	b.ip.NoLoopback = true
+	return b
+}
+
+// WithoutUnspecified forbids the unspecified addresses 0.0.0.0 and ::.
+func (b *IPAddrBuilder) WithoutUnspecified() *IPAddrBuilder {
+	b.ip.NoUnspecified = true
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *IPAddrBuilder) Required(options ...RequiredOption) Required[netip.Addr] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *IPAddrBuilder) Optional(options ...OptionalOption) Optional[netip.Addr] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *IPAddrBuilder) Deprecated(options ...DeprecatedOption) Deprecated[netip.Addr] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *IPAddrBuilder) element() (variable.TypedSchema[netip.Addr], *variable.TypedSpecBuilder[netip.Addr]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints, examples and documentation that depend on the
+// builder's options.
+//
+// It has no effect if it has already been called.
+func (b *IPAddrBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	b.builder.BuiltInConstraint(
+		"**MUST** be "+b.ip.describe("address"),
+		func(v netip.Addr) variable.ConstraintError {
+			return b.ip.check(v, "address")
+		},
+	)
+
+	if b.ip.Family != 6 {
+		b.builder.NonNormativeExample(
+			netip.MustParseAddr("192.0.2.1"),
+			"an IPv4 address reserved for documentation",
+		)
+	}
+
+	if b.ip.Family != 4 {
+		b.builder.NonNormativeExample(
+			netip.MustParseAddr("2001:db8::1"),
+			"an IPv6 address reserved for documentation",
+		)
+	}
+
+	buildIPAddrSyntaxDocumentation(b.builder.Documentation(), b.ip.Family)
+}
+
+// ipRestrictions is a set of restrictions on the IP addresses that may be used
+// by IP address and prefix variables.
+type ipRestrictions struct {
+	// Family is the IP version that is permitted, or 0 if both IPv4 and IPv6
+	// are permitted.
+	Family int
+
+	NoLoopback    bool
+	NoUnspecified bool
+}
+
+// describe returns a human-readable description of the restrictions, for use
+// in constraint descriptions. noun is "address" or "prefix".
+func (r ipRestrictions) describe(noun string) string {
+	desc := "a valid IP " + noun
+
+	switch r.Family {
+	case 4:
+		desc = "a valid IPv4 " + noun
+	case 6:
+		desc = "a valid IPv6 " + noun
+	}
+
+	if noun == "prefix" {
+		desc += " in CIDR notation"
+	}
+
+	var forbidden []string
+
+	if r.NoLoopback {
+		forbidden = append(forbidden, "loopback")
+	}
+
+	if r.NoUnspecified {
+		forbidden = append(forbidden, "unspecified")
+	}
+
+	if len(forbidden) != 0 {
+		article := "a"
+		if forbidden[0] == "unspecified" {
+			article = "an"
+		}
+
+		if noun == "address" {
+			desc += " that is not "
+		} else {
+			desc += " whose address is not "
+		}
+
+		desc += article + " " + strings.Join(forbidden, " or ") + " address"
+	}
+
+	return desc
+}
+
+// check returns an error if addr does not meet the restrictions. noun is
+// "address" or "prefix".
+func (r ipRestrictions) check(addr netip.Addr, noun string) error {
+	switch {
+	case r.Family == 4 && !addr.Is4():
+		return fmt.Errorf("expected an IPv4 %s", noun)
+	case r.Family == 6 && !addr.Is6():
+		return fmt.Errorf("expected an IPv6 %s", noun)
+	case r.NoLoopback && addr.IsLoopback():
+		return fmt.Errorf("%s must not be a loopback address", noun)
+	case r.NoUnspecified && addr.IsUnspecified():
+		return fmt.Errorf("%s must not be an unspecified address", noun)
+	}
+
+	return nil
+}
+
+type ipAddrMarshaler struct{}
+
+func (ipAddrMarshaler) Marshal(v netip.Addr) (variable.Literal, error) {
+	if !v.IsValid() {
+		return variable.Literal{}, nil
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (ipAddrMarshaler) Unmarshal(v variable.Literal) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(v.String)
+	if err != nil {
+		return netip.Addr{}, errors.New(
+			strings.TrimPrefix(
+				err.Error(),
+				fmt.Sprintf("ParseAddr(%q): ", v.String),
+			),
+		)
+	}
+
+	return addr, nil
+}
+
+func buildIPAddrSyntaxDocumentation(d variable.DocumentationBuilder, family int) {
+	d = d.Summary("IP address syntax")
+
+	if family != 6 {
+		d = d.
+			Paragraph(
+				"IPv4 addresses are specified in dotted-decimal notation,",
+				"such as `192.0.2.1`.",
+			).
+			Format()
+	}
+
+	if family != 4 {
+		d = d.
+			Paragraph(
+				"IPv6 addresses are specified as eight groups of hexadecimal digits separated by colons,",
+				"such as `2001:db8:0:0:0:0:0:1`.",
+				"A single run of consecutive zero groups may be replaced with `::`,",
+				"such as `2001:db8::1`.",
+			).
+			Format()
+	}
+
+	d.Done()
+}
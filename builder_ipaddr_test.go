@@ -0,0 +1,271 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type IPAddrBuilder", func() {
+	var builder *IPAddrBuilder
+
+	BeforeEach(func() {
+		builder = IPAddr("FERRITE_IP_ADDR", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			IPAddr("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			IPAddr("FERRITE_IP_ADDR", "").Optional()
+		}).To(PanicWith("specification for FERRITE_IP_ADDR is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value does not meet the restrictions", func() {
+		Expect(func() {
+			builder.
+				WithIPv6Only().
+				WithDefault("192.0.2.1").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_IP_ADDR is invalid: default value: expected an IPv6 address"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid address", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect netip.Addr) {
+						os.Setenv("FERRITE_IP_ADDR", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("IPv4", "192.0.2.1", netip.MustParseAddr("192.0.2.1")),
+					Entry("IPv6", "2001:db8:0:0:0:0:0:1", netip.MustParseAddr("2001:db8::1")),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_IP_ADDR", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"not an IP address",
+						"example.org",
+						`value of FERRITE_IP_ADDR (example.org) is invalid: unexpected character (at "example.org")`,
+					),
+					Entry(
+						"out of range octet",
+						"192.0.2.256",
+						`value of FERRITE_IP_ADDR (192.0.2.256) is invalid: IPv4 field has value >255`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("192.0.2.1").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(netip.MustParseAddr("192.0.2.1")))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_IP_ADDR is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the address is restricted", func() {
+		DescribeTable(
+			"it panics if the value does not meet the restrictions",
+			func(value string, restrict func(*IPAddrBuilder) *IPAddrBuilder, expect string) {
+				os.Setenv("FERRITE_IP_ADDR", value)
+
+				Expect(func() {
+					restrict(builder).
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"IPv4 only",
+				"2001:db8::1",
+				(*IPAddrBuilder).WithIPv4Only,
+				`value of FERRITE_IP_ADDR (2001:db8::1) is invalid: expected an IPv4 address`,
+			),
+			Entry(
+				"IPv6 only",
+				"192.0.2.1",
+				(*IPAddrBuilder).WithIPv6Only,
+				`value of FERRITE_IP_ADDR (192.0.2.1) is invalid: expected an IPv6 address`,
+			),
+			Entry(
+				"loopback forbidden",
+				"127.0.0.1",
+				(*IPAddrBuilder).WithoutLoopback,
+				`value of FERRITE_IP_ADDR (127.0.0.1) is invalid: address must not be a loopback address`,
+			),
+			Entry(
+				"unspecified forbidden",
+				"::",
+				(*IPAddrBuilder).WithoutUnspecified,
+				`value of FERRITE_IP_ADDR (::) is invalid: address must not be an unspecified address`,
+			),
+		)
+	})
+})
+
+func ExampleIPAddr_required() {
+	defer example()()
+
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		Required()
+
+	os.Setenv("FERRITE_IP_ADDR", "192.0.2.1")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 192.0.2.1
+}
+
+func ExampleIPAddr_default() {
+	defer example()()
+
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		WithDefault("0.0.0.0").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 0.0.0.0
+}
+
+func ExampleIPAddr_optional() {
+	defer example()()
+
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleIPAddr_restricted() {
+	defer example()()
+
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		WithIPv6Only().
+		WithoutLoopback().
+		WithoutUnspecified().
+		Required()
+
+	os.Setenv("FERRITE_IP_ADDR", "2001:db8:0:0:0:0:0:1")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2001:db8::1
+}
+
+func ExampleIPAddr_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_IP_ADDR", "2001:db8:0:0:0:0:0:1")
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_IP_ADDR  example IP address variable  [ <string> ]  ⚠ deprecated variable set to 2001:db8:0:0:0:0:0:1, equivalent to 2001:db8::1
+	//
+	// value is 2001:db8::1
+}
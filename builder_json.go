@@ -0,0 +1,330 @@
+package ferrite
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// JSON configures an environment variable as a JSON document that is
+// unmarshaled into a value of type T.
+//
+// The value is decoded using the standard encoding/json package. Object fields
+// that do not correspond to a field of T are rejected, rather than ignored, so
+// that misspelled field names are reported when the environment is validated.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func JSON[T any](name, desc string) *JSONBuilder[T] {
+	b := &JSONBuilder[T]{
+		schema: variable.TypedOther[T]{
+			Marshaler: jsonMarshaler[T]{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// JSONBuilder builds a specification for a JSON variable.
+type JSONBuilder[T any] struct {
+	schema    variable.TypedOther[T]
+	builder   variable.TypedSpecBuilder[T]
+	completed bool
+}
+
+var _ isBuilderOf[struct{}, *JSONBuilder[struct{}]]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. The
+// canonical JSON encoding of v is used as an example in the generated
+// documentation.
+func (b *JSONBuilder[T]) WithDefault(v T) *JSONBuilder[T] {
+	b.builder.Default(v)
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *JSONBuilder[T]) WithConstraint(
+	desc string,
+	fn func(T) bool,
+) *JSONBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithSensitiveContent marks the variable as containing sensitive content.
+//
+// Values of sensitive variables are not printed to the console or included in
+// generated documentation.
+func (b *JSONBuilder[T]) WithSensitiveContent() *JSONBuilder[T] {
+	b.builder.MarkSensitive()
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *JSONBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *JSONBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *JSONBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *JSONBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints and documentation that depend on the builder's
+// options.
+//
+// It has no effect if it has already been called.
+func (b *JSONBuilder[T]) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	b.schema.Requirement = "**MUST** be a valid JSON document"
+
+	b.builder.Documentation().
+		Summary("JSON syntax").
+		Paragraph(
+			"The value must be a single JSON document.",
+			"Object fields that are not recognized by the application are rejected, rather than ignored,",
+			"so that misspelled field names are detected.",
+		).
+		Format().
+		Paragraph(
+			"Whitespace is permitted within the document,",
+			"but it is not part of the canonical representation of the value.",
+		).
+		Format().
+		Done()
+}
+
+type jsonMarshaler[T any] struct{}
+
+func (jsonMarshaler[T]) Marshal(v T) (variable.Literal, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return variable.Literal{}, errors.New(
+			strings.TrimPrefix(err.Error(), "json: "),
+		)
+	}
+
+	return variable.Literal{
+		String: string(data),
+	}, nil
+}
+
+func (jsonMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
+	var value T
+
+	dec := json.NewDecoder(strings.NewReader(v.String))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&value); err != nil {
+		return value, jsonError(v.String, err)
+	}
+
+	offset := dec.InputOffset()
+	rest := v.String[offset:]
+	trimmed := strings.TrimLeft(rest, " \t\r\n")
+
+	if trimmed != "" {
+		return value, fmt.Errorf(
+			"syntax error at offset %d: unexpected data after the end of the JSON document",
+			offset+int64(len(rest)-len(trimmed)),
+		)
+	}
+
+	return value, nil
+}
+
+// jsonError returns a description of an error that occurred while decoding the
+// JSON document s, including the offset of the error if it is known.
+func jsonError(s string, err error) error {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.As(err, &syntaxErr):
+		// The offset of a syntax error is the number of bytes read before the
+		// error occurred, which includes the offending character.
+		offset := syntaxErr.Offset
+		if offset > 0 {
+			offset--
+		}
+
+		return fmt.Errorf(
+			"syntax error at offset %d: %s",
+			offset,
+			syntaxErr,
+		)
+
+	case errors.As(err, &typeErr):
+		if typeErr.Field == "" {
+			return fmt.Errorf(
+				"type error at offset %d: unexpected JSON %s, expected %s",
+				typeErr.Offset,
+				typeErr.Value,
+				jsonTypeName(typeErr.Type),
+			)
+		}
+
+		return fmt.Errorf(
+			"type error at offset %d: unexpected JSON %s in the %q field, expected %s",
+			typeErr.Offset,
+			typeErr.Value,
+			typeErr.Field,
+			jsonTypeName(typeErr.Type),
+		)
+
+	case strings.HasPrefix(err.Error(), jsonUnknownFieldPrefix):
+		// The encoding/json package does not export a type for this error, nor
+		// does it report the offset of the offending field.
+		field, err := strconv.Unquote(
+			strings.TrimPrefix(err.Error(), jsonUnknownFieldPrefix),
+		)
+
+		if err == nil {
+			if offset, ok := jsonFieldOffset(s, field); ok {
+				return fmt.Errorf(
+					"field error at offset %d: unknown field %q",
+					offset,
+					field,
+				)
+			}
+
+			return fmt.Errorf("unknown field %q", field)
+		}
+
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return fmt.Errorf(
+			"syntax error at offset %d: unexpected end of JSON input",
+			len(s),
+		)
+	}
+
+	return errors.New(
+		strings.TrimPrefix(err.Error(), "json: "),
+	)
+}
+
+// jsonUnknownFieldPrefix is the prefix of the error message produced by the
+// encoding/json package when an object contains an unknown field.
+const jsonUnknownFieldPrefix = "json: unknown field "
+
+// jsonFieldOffset returns the offset of the object key named field within the
+// JSON document s.
+//
+// ok is false if the key does not occur exactly once, in which case the offset
+// of the field that caused the error can not be determined unambiguously.
+func jsonFieldOffset(s, field string) (offset int64, ok bool) {
+	type scope struct {
+		IsObject  bool
+		ExpectKey bool
+	}
+
+	var (
+		dec   = json.NewDecoder(strings.NewReader(s))
+		stack []scope
+		found int
+	)
+
+	// valueDone records that a complete value has been read, such that the
+	// next token within an object is a key.
+	valueDone := func() {
+		if n := len(stack); n != 0 && stack[n-1].IsObject {
+			stack[n-1].ExpectKey = true
+		}
+	}
+
+	for {
+		start := dec.InputOffset()
+
+		tok, err := dec.Token()
+		if err != nil {
+			return offset, found == 1
+		}
+
+		switch tok {
+		case json.Delim('}'), json.Delim(']'):
+			stack = stack[:len(stack)-1]
+			valueDone()
+			continue
+		}
+
+		if n := len(stack); n != 0 && stack[n-1].ExpectKey {
+			stack[n-1].ExpectKey = false
+
+			if tok == field {
+				// The offset before the key includes any separator and
+				// whitespace after the previous token.
+				rest := s[start:]
+				offset = start + int64(len(rest)-len(strings.TrimLeft(rest, " \t\r\n,")))
+				found++
+			}
+
+			continue
+		}
+
+		switch tok {
+		case json.Delim('{'):
+			stack = append(stack, scope{IsObject: true, ExpectKey: true})
+		case json.Delim('['):
+			stack = append(stack, scope{})
+		default:
+			valueDone()
+		}
+	}
+}
+
+// jsonTypeName returns a human-readable name for the JSON type that is
+// unmarshaled into values of type t.
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	}
+
+	return t.String()
+}
@@ -0,0 +1,286 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type retryPolicy struct {
+	Attempts int    `json:"attempts"`
+	Backoff  string `json:"backoff,omitempty"`
+}
+
+var _ = Describe("type JSONBuilder", func() {
+	var builder *JSONBuilder[retryPolicy]
+
+	BeforeEach(func() {
+		builder = JSON[retryPolicy]("FERRITE_JSON", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			JSON[retryPolicy]("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			JSON[retryPolicy]("FERRITE_JSON", "").Optional()
+		}).To(PanicWith("specification for FERRITE_JSON is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value can not be encoded as JSON", func() {
+		Expect(func() {
+			JSON[func()]("FERRITE_JSON", "<desc>").
+				WithDefault(func() {}).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_JSON is invalid: default value: unsupported type: func()"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid JSON document", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the unmarshaled value",
+					func(value string, expect retryPolicy) {
+						os.Setenv("FERRITE_JSON", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("compact", `{"attempts":3,"backoff":"1s"}`, retryPolicy{3, "1s"}),
+					Entry("with whitespace", ` { "attempts": 3 } `, retryPolicy{Attempts: 3}),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_JSON", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"invalid syntax",
+						`{"attempts" 3}`,
+						`value of FERRITE_JSON ('{"attempts" 3}') is invalid: syntax error at offset 12: invalid character '3' after object key`,
+					),
+					Entry(
+						"incomplete document",
+						`{"attempts":3`,
+						`value of FERRITE_JSON ('{"attempts":3') is invalid: syntax error at offset 13: unexpected end of JSON input`,
+					),
+					Entry(
+						"trailing data",
+						`{"attempts":3} {}`,
+						`value of FERRITE_JSON ('{"attempts":3} {}') is invalid: syntax error at offset 15: unexpected data after the end of the JSON document`,
+					),
+					Entry(
+						"unknown field",
+						`{"attempts":3,"backof":"1s"}`,
+						`value of FERRITE_JSON ('{"attempts":3,"backof":"1s"}') is invalid: field error at offset 14: unknown field "backof"`,
+					),
+					Entry(
+						"unknown field with whitespace",
+						`{ "attempts": 3, "backof": "1s" }`,
+						`value of FERRITE_JSON ('{ "attempts": 3, "backof": "1s" }') is invalid: field error at offset 17: unknown field "backof"`,
+					),
+					Entry(
+						"unknown field that occurs more than once",
+						`{"backof":"1s","backof":"2s"}`,
+						`value of FERRITE_JSON ('{"backof":"1s","backof":"2s"}') is invalid: unknown field "backof"`,
+					),
+					Entry(
+						"field of the wrong type",
+						`{"attempts":"3"}`,
+						`value of FERRITE_JSON ('{"attempts":"3"}') is invalid: type error at offset 15: unexpected JSON string in the "attempts" field, expected number`,
+					),
+					Entry(
+						"document of the wrong type",
+						`[3]`,
+						`value of FERRITE_JSON ('[3]') is invalid: type error at offset 1: unexpected JSON array, expected object`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(retryPolicy{Attempts: 5}).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(retryPolicy{Attempts: 5}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_JSON is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("there is a user-defined constraint", func() {
+		It("panics if the value does not satisfy the constraint", func() {
+			os.Setenv("FERRITE_JSON", `{"attempts":0}`)
+
+			Expect(func() {
+				builder.
+					WithConstraint(
+						"must allow at least one attempt",
+						func(v retryPolicy) bool {
+							return v.Attempts > 0
+						},
+					).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_JSON ('{"attempts":0}') is invalid: must allow at least one attempt`,
+			))
+		})
+	})
+})
+
+func ExampleJSON_required() {
+	defer example()()
+
+	type RetryPolicy struct {
+		Attempts int    `json:"attempts"`
+		Backoff  string `json:"backoff"`
+	}
+
+	v := ferrite.
+		JSON[RetryPolicy]("FERRITE_JSON", "example JSON variable").
+		Required()
+
+	os.Setenv("FERRITE_JSON", `{"attempts": 3, "backoff": "1s"}`)
+	ferrite.Init()
+
+	fmt.Printf("value is %+v\n", v.Value())
+
+	// Output:
+	// value is {Attempts:3 Backoff:1s}
+}
+
+func ExampleJSON_default() {
+	defer example()()
+
+	type RetryPolicy struct {
+		Attempts int    `json:"attempts"`
+		Backoff  string `json:"backoff"`
+	}
+
+	v := ferrite.
+		JSON[RetryPolicy]("FERRITE_JSON", "example JSON variable").
+		WithDefault(RetryPolicy{Attempts: 5, Backoff: "500ms"}).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Printf("value is %+v\n", v.Value())
+
+	// Output:
+	// value is {Attempts:5 Backoff:500ms}
+}
+
+func ExampleJSON_optional() {
+	defer example()()
+
+	type RetryPolicy struct {
+		Attempts int    `json:"attempts"`
+		Backoff  string `json:"backoff"`
+	}
+
+	v := ferrite.
+		JSON[RetryPolicy]("FERRITE_JSON", "example JSON variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Printf("value is %+v\n", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleJSON_deprecated() {
+	defer example()()
+
+	type RetryPolicy struct {
+		Attempts int    `json:"attempts"`
+		Backoff  string `json:"backoff"`
+	}
+
+	os.Setenv("FERRITE_JSON", `{"attempts": 3, "backoff": "1s"}`)
+	v := ferrite.
+		JSON[RetryPolicy]("FERRITE_JSON", "example JSON variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Printf("value is %+v\n", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_JSON  example JSON variable  [ <string> ]  ⚠ deprecated variable set to '{"attempts": 3, "backoff": "1s"}', equivalent to '{"attempts":3,"backoff":"1s"}'
+	//
+	// value is {Attempts:3 Backoff:1s}
+}
@@ -0,0 +1,201 @@
+package ferrite
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// List configures an environment variable as a list of strings.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// By default, the elements of the list are separated by commas. Whitespace
+// surrounding each element is ignored.
+func List(name, desc string) *ListBuilder[string] {
+	return ListOf[string](name, desc, String("", ""))
+}
+
+// ListOf configures an environment variable as a list of values of type T.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// elem is a builder that describes each element of the list, such as the
+// builder returned by Signed(), Duration() or Enum(). Its name and description
+// are not used and may be empty. The element builder's constraints, examples
+// and syntax documentation apply to each element of the list. If the element
+// builder has sensitive content, so does the list. The element builder must not
+// have a default value, use WithDefault() on the list instead.
+//
+// The element builder is not read until the list's build process is completed,
+// so it may still be configured after ListOf() is called.
+//
+// By default, the elements of the list are separated by commas. Whitespace
+// surrounding each element is ignored.
+func ListOf[T any](name, desc string, elem ElementBuilder[T]) *ListBuilder[T] {
+	b := &ListBuilder[T]{
+		elem: elem,
+		schema: variable.TypedList[T]{
+			Sep: ",",
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// ListBuilder builds a specification for a list variable.
+type ListBuilder[T any] struct {
+	elem      ElementBuilder[T]
+	schema    variable.TypedList[T]
+	builder   variable.TypedSpecBuilder[[]T]
+	completed bool
+}
+
+var _ isBuilderOf[[]string, *ListBuilder[string]]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *ListBuilder[T]) WithDefault(v ...T) *ListBuilder[T] {
+	b.builder.Default(v)
+	return b
+}
+
+// WithSeparator sets the string used to separate the elements of the list.
+//
+// sep must not be empty or consist only of whitespace.
+func (b *ListBuilder[T]) WithSeparator(sep string) *ListBuilder[T] {
+	b.schema.Sep = sep
+	return b
+}
+
+// WithMinimumElements sets the minimum number of elements in the list.
+func (b *ListBuilder[T]) WithMinimumElements(n int) *ListBuilder[T] {
+	b.schema.MinLen = maybe.Some(n)
+	return b
+}
+
+// WithMaximumElements sets the maximum number of elements in the list.
+func (b *ListBuilder[T]) WithMaximumElements(n int) *ListBuilder[T] {
+	b.schema.MaxLen = maybe.Some(n)
+	return b
+}
+
+// WithUniqueElements requires that the elements of the list are unique.
+//
+// Elements are compared using their canonical representation, so values that
+// are equivalent but written differently are considered duplicates.
+func (b *ListBuilder[T]) WithUniqueElements() *ListBuilder[T] {
+	b.schema.Unique = true
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *ListBuilder[T]) WithConstraint(
+	desc string,
+	fn func([]T) bool,
+) *ListBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithSensitiveContent marks the variable as containing sensitive content.
+//
+// Values of sensitive variables are not printed to the console or included in
+// generated documentation.
+func (b *ListBuilder[T]) WithSensitiveContent() *ListBuilder[T] {
+	b.builder.MarkSensitive()
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *ListBuilder[T]) Required(options ...RequiredOption) Required[[]T] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *ListBuilder[T]) Optional(options ...OptionalOption) Optional[[]T] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *ListBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[[]T] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+// complete reads the schema, constraints, examples and documentation of the
+// element builder.
+func (b *ListBuilder[T]) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	s, eb := b.elem.element()
+
+	if eb.HasDefault() {
+		panic(fmt.Sprintf(
+			"specification for %s is invalid: element: default values are not supported, use WithDefault() on the list instead",
+			b.builder.Peek().Name(),
+		))
+	}
+
+	b.schema.Elem = s
+	b.schema.ElemConstraints = eb.Constraints()
+	b.schema.ElemExamples = eb.Examples()
+
+	if eb.Peek().IsSensitive() {
+		b.builder.MarkSensitive()
+	}
+
+	inheritDocumentation(&b.builder, eb)
+}
+
+// inheritDocumentation adds the documentation from the spec builder of an
+// element to the spec builder of the variable that contains it.
+//
+// Documentation with the same summary as existing documentation is skipped, so
+// that compound values with several elements of the same type are not
+// documented more than once.
+func inheritDocumentation[T, E any](
+	b *variable.TypedSpecBuilder[T],
+	elem *variable.TypedSpecBuilder[E],
+) {
+	existing := b.Peek().Documentation()
+
+next:
+	for _, doc := range elem.Peek().Documentation() {
+		for _, x := range existing {
+			if x.Summary != "" && x.Summary == doc.Summary {
+				continue next
+			}
+		}
+
+		d := b.Documentation().Summary(doc.Summary)
+
+		for _, p := range doc.Paragraphs {
+			d = d.Paragraph("%s").Format(p)
+		}
+
+		if doc.IsImportant {
+			d = d.Important()
+		}
+
+		d.Done()
+	}
+}
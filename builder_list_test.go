@@ -0,0 +1,488 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type ListBuilder", func() {
+	var builder *ListBuilder[string]
+
+	BeforeEach(func() {
+		builder = List("FERRITE_LIST", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			List("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			List("FERRITE_LIST", "").Optional()
+		}).To(PanicWith("specification for FERRITE_LIST is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the separator is empty", func() {
+		Expect(func() {
+			builder.
+				WithSeparator(" ").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_LIST is invalid: separator must not be empty or whitespace"))
+	})
+
+	It("panics if the maximum number of elements is less than the minimum", func() {
+		Expect(func() {
+			builder.
+				WithMinimumElements(3).
+				WithMaximumElements(2).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_LIST is invalid: maximum elements: must be at least 3"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the elements with surrounding whitespace removed", func() {
+					os.Setenv("FERRITE_LIST", "foo, bar ,baz")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(Equal([]string{"foo", "bar", "baz"}))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_LIST", value)
+
+						Expect(func() {
+							builder.
+								WithMinimumElements(2).
+								WithMaximumElements(3).
+								WithUniqueElements().
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"too few elements",
+						"foo",
+						`value of FERRITE_LIST (foo) is invalid: too few elements, expected between 2 and 3`,
+					),
+					Entry(
+						"too many elements",
+						"foo,bar,baz,qux",
+						`value of FERRITE_LIST (foo,bar,baz,qux) is invalid: too many elements, expected between 2 and 3`,
+					),
+					Entry(
+						"empty element",
+						"foo,,bar",
+						`value of FERRITE_LIST (foo,,bar) is invalid: element #2 () is invalid: must not be empty`,
+					),
+					Entry(
+						"duplicate element",
+						"foo,bar,foo",
+						`value of FERRITE_LIST (foo,bar,foo) is invalid: element #3 (foo) is a duplicate of element #1, expected unique elements`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("foo", "bar").
+							Required().
+							Value()
+
+						Expect(v).To(Equal([]string{"foo", "bar"}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_LIST is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the value", func() {
+					os.Setenv("FERRITE_LIST", "foo;bar")
+
+					v, ok := builder.
+						WithSeparator(";").
+						Optional().
+						Value()
+
+					Expect(ok).To(BeTrue())
+					Expect(v).To(Equal([]string{"foo", "bar"}))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the elements are not strings", func() {
+		It("applies the element schema to each element", func() {
+			os.Setenv("FERRITE_LIST", "1,+2,3")
+
+			v := ferrite.
+				ListOf[int]("FERRITE_LIST", "<desc>", Signed[int]("", "")).
+				Required().
+				Value()
+
+			Expect(v).To(Equal([]int{1, 2, 3}))
+		})
+
+		It("reports the offending element", func() {
+			os.Setenv("FERRITE_LIST", "1s,2h,3d")
+
+			Expect(func() {
+				ferrite.
+					ListOf[time.Duration]("FERRITE_LIST", "<desc>", Duration("", "")).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_LIST (1s,2h,3d) is invalid: element #3 (3d) is invalid: unknown unit "d"`,
+			))
+		})
+
+		It("applies the element constraints to each element", func() {
+			os.Setenv("FERRITE_LIST", "https://example.org,example.org")
+
+			Expect(func() {
+				ferrite.
+					ListOf[*url.URL]("FERRITE_LIST", "<desc>", URL("", "")).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_LIST (https://example.org,example.org) is invalid: element #2 (example.org) is invalid: URL must have a scheme`,
+			))
+		})
+
+		It("uses options applied to the element builder after ListOf() is called", func() {
+			os.Setenv("FERRITE_LIST", "1,2,3")
+
+			elem := Signed[int]("", "")
+			list := ferrite.ListOf[int]("FERRITE_LIST", "<desc>", elem)
+			elem.WithMaximum(2)
+
+			Expect(func() {
+				list.
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_LIST (1,2,3) is invalid: element #3 (3) is invalid: too high, expected +2 or less`,
+			))
+		})
+
+		It("panics if the element builder has a default value", func() {
+			Expect(func() {
+				ferrite.
+					ListOf[int]("FERRITE_LIST", "<desc>", Signed[int]("", "").WithDefault(1)).
+					Required()
+			}).To(PanicWith(
+				"specification for FERRITE_LIST is invalid: element: default values are not supported, use WithDefault() on the list instead",
+			))
+		})
+
+		It("does not check runtime element constraints when the variable is built", func() {
+			Expect(func() {
+				ferrite.
+					ListOf[FileName]("FERRITE_LIST", "<desc>", File("", "").WithMustExist()).
+					WithDefault("testdata/does-not-exist").
+					Required()
+			}).NotTo(Panic())
+		})
+
+		It("checks runtime element constraints against the default value", func() {
+			Expect(func() {
+				ferrite.
+					ListOf[FileName]("FERRITE_LIST", "<desc>", File("", "").WithMustExist()).
+					WithDefault("testdata/does-not-exist").
+					Required().
+					Value()
+			}).To(PanicWith(
+				`default value of FERRITE_LIST (testdata/does-not-exist) is invalid: element #1 (testdata/does-not-exist) is invalid: file does not exist`,
+			))
+		})
+	})
+
+	When("the element builder is also used to build its own variable", func() {
+		DescribeTable(
+			"it does not repeat the element's constraints, examples or documentation",
+			func(build func(reg *variable.Registry, list bool)) {
+				elem := func(list bool) variable.Spec {
+					reg := &variable.Registry{}
+					build(reg, list)
+
+					for _, s := range reg.Specs() {
+						if s.Name() == "FERRITE_ELEM" {
+							return s
+						}
+					}
+
+					panic("element variable not registered")
+				}
+
+				want := elem(false)
+				got := elem(true)
+
+				Expect(got.Constraints()).To(HaveLen(len(want.Constraints())))
+				Expect(got.Examples()).To(HaveLen(len(want.Examples())))
+				Expect(got.Documentation()).To(HaveLen(len(want.Documentation())))
+			},
+			Entry(
+				"decimal",
+				func(reg *variable.Registry, list bool) {
+					b := Decimal("FERRITE_ELEM", "<desc>")
+					if list {
+						ferrite.
+							ListOf[*big.Rat]("FERRITE_LIST", "<desc>", b).
+							Required(WithRegistry(reg))
+					}
+					b.Required(WithRegistry(reg))
+				},
+			),
+			Entry(
+				"file",
+				func(reg *variable.Registry, list bool) {
+					b := File("FERRITE_ELEM", "<desc>").WithMustExist()
+					if list {
+						ferrite.
+							ListOf[FileName]("FERRITE_LIST", "<desc>", b).
+							Required(WithRegistry(reg))
+					}
+					b.Required(WithRegistry(reg))
+				},
+			),
+			Entry(
+				"UUID",
+				func(reg *variable.Registry, list bool) {
+					b := UUID("FERRITE_ELEM", "<desc>")
+					if list {
+						ferrite.
+							ListOf[UUIDValue]("FERRITE_LIST", "<desc>", b).
+							Required(WithRegistry(reg))
+					}
+					b.Required(WithRegistry(reg))
+				},
+			),
+		)
+	})
+
+	When("the element builder has sensitive content", func() {
+		DescribeTable(
+			"the list has sensitive content",
+			func(build func(reg *variable.Registry)) {
+				reg := &variable.Registry{}
+				build(reg)
+
+				for _, v := range reg.Variables() {
+					Expect(v.Spec().IsSensitive()).To(BeTrue())
+				}
+			},
+			Entry(
+				"sensitive string",
+				func(reg *variable.Registry) {
+					ferrite.
+						ListOf[string]("FERRITE_LIST", "<desc>", String("", "").WithSensitiveContent()).
+						Required(WithRegistry(reg))
+				},
+			),
+			Entry(
+				"binary data",
+				func(reg *variable.Registry) {
+					ferrite.
+						ListOf[[]byte]("FERRITE_LIST", "<desc>", Bytes("", "")).
+						Required(WithRegistry(reg))
+				},
+			),
+		)
+	})
+})
+
+func ExampleList_required() {
+	defer example()()
+
+	v := ferrite.
+		List("FERRITE_LIST", "example list variable").
+		Required()
+
+	os.Setenv("FERRITE_LIST", "red, green, blue")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is [red green blue]
+}
+
+func ExampleList_default() {
+	defer example()()
+
+	v := ferrite.
+		List("FERRITE_LIST", "example list variable").
+		WithDefault("red", "green").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is [red green]
+}
+
+func ExampleList_optional() {
+	defer example()()
+
+	v := ferrite.
+		List("FERRITE_LIST", "example list variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleListOf() {
+	defer example()()
+
+	v := ferrite.
+		ListOf[uint16](
+		"FERRITE_LIST",
+		"example list variable",
+		ferrite.
+			Unsigned[uint16]("", "").
+			WithMaximum(1024),
+	).
+		WithSeparator(";").
+		WithUniqueElements().
+		Required()
+
+	os.Setenv("FERRITE_LIST", "80;443")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is [80 443]
+}
+
+func ExampleList_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_LIST", "red, green")
+	v := ferrite.
+		List("FERRITE_LIST", "example list variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_LIST  example list variable  [ <string>, ... ]  ⚠ deprecated variable set to 'red, green', equivalent to red,green
+	//
+	// value is [red green]
+}
+
+func ExampleList_sensitive() {
+	defer example()()
+
+	os.Setenv("FERRITE_LIST", "tok-secret-a,tok-secret-b")
+	ferrite.
+		ListOf[string](
+		"FERRITE_LIST",
+		"example sensitive list variable",
+		ferrite.
+			String("", "").
+			WithSensitiveContent(),
+	).
+		Required()
+
+	os.Setenv("FERRITE_LIST_INVALID", "tok-secret-a,tok-secret-a")
+	ferrite.
+		ListOf[string](
+		"FERRITE_LIST_INVALID",
+		"example invalid sensitive list variable",
+		ferrite.
+			String("", "").
+			WithSensitiveContent(),
+	).
+		WithUniqueElements().
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_LIST          example sensitive list variable            <string>, ...    ✓ set to *************************
+	//  ❯ FERRITE_LIST_INVALID  example invalid sensitive list variable    <string>, ...    ✗ set to *************************, element #2 is a duplicate of element #1, expected unique elements
+	//
+	// <process exited with error code 1>
+}
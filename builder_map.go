@@ -0,0 +1,151 @@
+package ferrite
+
+import (
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Map configures an environment variable as a map of string keys to string
+// values.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// By default, the value is a comma-separated list of key/value pairs, such as
+// "foo=1,bar=2". Whitespace surrounding each key and value is ignored.
+func Map(name, desc string) *MapBuilder[string, string] {
+	return MapOf[string, string](name, desc, String("", ""), String("", ""))
+}
+
+// MapOf configures an environment variable as a map of keys of type K to
+// values of type V.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// key and value are builders that describe each key and value within the map,
+// such as the builders returned by String(), Signed() or Enum(). Their names
+// and descriptions are not used and may be empty. The constraints, examples
+// and syntax documentation of each builder apply to the keys and values,
+// respectively. If either builder has sensitive content, so does the map.
+//
+// By default, the value is a comma-separated list of key/value pairs, such as
+// "foo=1,bar=2". Whitespace surrounding each key and value is ignored.
+func MapOf[K comparable, V any](
+	name, desc string,
+	key ElementBuilder[K],
+	value ElementBuilder[V],
+) *MapBuilder[K, V] {
+	ks, kb := key.element()
+	vs, vb := value.element()
+
+	b := &MapBuilder[K, V]{
+		schema: variable.TypedMap[K, V]{
+			KeyElem:              ks,
+			KeyElemConstraints:   kb.Constraints(),
+			KeyElemExamples:      kb.Examples(),
+			ValueElem:            vs,
+			ValueElemConstraints: vb.Constraints(),
+			ValueElemExamples:    vb.Examples(),
+			PairSep:              ",",
+			KeyValueSep:          "=",
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	inheritDocumentation(&b.builder, kb)
+	inheritDocumentation(&b.builder, vb)
+
+	if kb.Peek().IsSensitive() || vb.Peek().IsSensitive() {
+		b.builder.MarkSensitive()
+	}
+
+	return b
+}
+
+// MapBuilder builds a specification for a map variable.
+type MapBuilder[K comparable, V any] struct {
+	schema  variable.TypedMap[K, V]
+	builder variable.TypedSpecBuilder[map[K]V]
+}
+
+var _ isBuilderOf[map[string]string, *MapBuilder[string, string]]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *MapBuilder[K, V]) WithDefault(v map[K]V) *MapBuilder[K, V] {
+	b.builder.Default(v)
+	return b
+}
+
+// WithPairSeparator sets the string used to separate each key/value pair.
+//
+// sep must not be empty or consist only of whitespace.
+func (b *MapBuilder[K, V]) WithPairSeparator(sep string) *MapBuilder[K, V] {
+	b.schema.PairSep = sep
+	return b
+}
+
+// WithKeyValueSeparator sets the string used to separate each key from its
+// value.
+//
+// sep must not be empty or consist only of whitespace.
+func (b *MapBuilder[K, V]) WithKeyValueSeparator(sep string) *MapBuilder[K, V] {
+	b.schema.KeyValueSep = sep
+	return b
+}
+
+// WithRequiredKeys adds keys that must be present in the map.
+func (b *MapBuilder[K, V]) WithRequiredKeys(keys ...K) *MapBuilder[K, V] {
+	b.schema.Required = append(b.schema.Required, keys...)
+	return b
+}
+
+// WithAllowedKeys restricts the keys that may be present in the map.
+//
+// If this method is not called, any key that is valid according to the key
+// builder is allowed. Any required keys must also be allowed.
+func (b *MapBuilder[K, V]) WithAllowedKeys(keys ...K) *MapBuilder[K, V] {
+	b.schema.Allowed = append(b.schema.Allowed, keys...)
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *MapBuilder[K, V]) WithConstraint(
+	desc string,
+	fn func(map[K]V) bool,
+) *MapBuilder[K, V] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithSensitiveContent marks the variable as containing sensitive content.
+//
+// Values of sensitive variables are not printed to the console or included in
+// generated documentation.
+func (b *MapBuilder[K, V]) WithSensitiveContent() *MapBuilder[K, V] {
+	b.builder.MarkSensitive()
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *MapBuilder[K, V]) Required(options ...RequiredOption) Required[map[K]V] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *MapBuilder[K, V]) Optional(options ...OptionalOption) Optional[map[K]V] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *MapBuilder[K, V]) Deprecated(options ...DeprecatedOption) Deprecated[map[K]V] {
+	return deprecated(b.schema, &b.builder, options...)
+}
@@ -0,0 +1,387 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type MapBuilder", func() {
+	var builder *MapBuilder[string, string]
+
+	BeforeEach(func() {
+		builder = Map("FERRITE_MAP", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Map("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Map("FERRITE_MAP", "").Optional()
+		}).To(PanicWith("specification for FERRITE_MAP is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the pair separator is empty", func() {
+		Expect(func() {
+			builder.
+				WithPairSeparator("").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_MAP is invalid: pair separator must not be empty or whitespace"))
+	})
+
+	It("panics if the key/value separator is empty", func() {
+		Expect(func() {
+			builder.
+				WithKeyValueSeparator(" ").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_MAP is invalid: key/value separator must not be empty or whitespace"))
+	})
+
+	It("panics if the separators overlap", func() {
+		Expect(func() {
+			builder.
+				WithKeyValueSeparator(",").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_MAP is invalid: pair separator and key/value separator must not overlap"))
+	})
+
+	It("panics if a required key is not allowed", func() {
+		Expect(func() {
+			builder.
+				WithAllowedKeys("foo", "bar").
+				WithRequiredKeys("baz").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_MAP is invalid: required key baz is not one of the allowed keys"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the pairs with surrounding whitespace removed", func() {
+					os.Setenv("FERRITE_MAP", "foo = 1, bar=2 ,baz=x=y")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(Equal(map[string]string{
+						"foo": "1",
+						"bar": "2",
+						"baz": "x=y",
+					}))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_MAP", value)
+
+						Expect(func() {
+							builder.
+								WithAllowedKeys("foo", "bar", "baz").
+								WithRequiredKeys("foo").
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing separator",
+						"foo=1,bar",
+						`value of FERRITE_MAP (foo=1,bar) is invalid: pair #2 is missing the "=" separator`,
+					),
+					Entry(
+						"empty key",
+						"foo=1,=2",
+						`value of FERRITE_MAP (foo=1,=2) is invalid: pair #2 has an empty key`,
+					),
+					Entry(
+						"empty value",
+						"foo=1,bar=",
+						`value of FERRITE_MAP (foo=1,bar=) is invalid: value of key bar () is invalid: must not be empty`,
+					),
+					Entry(
+						"duplicate key",
+						"foo=1,foo=2",
+						`value of FERRITE_MAP (foo=1,foo=2) is invalid: key foo is specified more than once`,
+					),
+					Entry(
+						"unexpected key",
+						"foo=1,qux=2",
+						`value of FERRITE_MAP (foo=1,qux=2) is invalid: key qux is not permitted, expected one of foo, bar, baz`,
+					),
+					Entry(
+						"missing required key",
+						"bar=1",
+						`value of FERRITE_MAP (bar=1) is invalid: required key foo is missing`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(map[string]string{"foo": "1"}).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(map[string]string{"foo": "1"}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_MAP is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is not empty", func() {
+			Describe("func Value()", func() {
+				It("returns the value", func() {
+					os.Setenv("FERRITE_MAP", "foo:1;bar:2")
+
+					v, ok := builder.
+						WithPairSeparator(";").
+						WithKeyValueSeparator(":").
+						Optional().
+						Value()
+
+					Expect(ok).To(BeTrue())
+					Expect(v).To(Equal(map[string]string{
+						"foo": "1",
+						"bar": "2",
+					}))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the keys and values are not strings", func() {
+		It("applies the key and value schemas", func() {
+			os.Setenv("FERRITE_MAP", "1=1s,+2=1m")
+
+			v := ferrite.
+				MapOf[int, time.Duration]("FERRITE_MAP", "<desc>", Signed[int]("", ""), Duration("", "")).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(map[int]time.Duration{
+				1: time.Second,
+				2: time.Minute,
+			}))
+		})
+
+		It("reports the offending key", func() {
+			os.Setenv("FERRITE_MAP", "1=1s,x=1m")
+
+			Expect(func() {
+				ferrite.
+					MapOf[int, time.Duration]("FERRITE_MAP", "<desc>", Signed[int]("", ""), Duration("", "")).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_MAP (1=1s,x=1m) is invalid: key x is invalid: unrecognized int syntax`,
+			))
+		})
+
+		It("reports the value of the offending key", func() {
+			os.Setenv("FERRITE_MAP", "1=1s,2=1d")
+
+			Expect(func() {
+				ferrite.
+					MapOf[int, time.Duration]("FERRITE_MAP", "<desc>", Signed[int]("", ""), Duration("", "")).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_MAP (1=1s,2=1d) is invalid: value of key 2 (1d) is invalid: unknown unit "d"`,
+			))
+		})
+
+		It("treats equivalent keys as duplicates", func() {
+			os.Setenv("FERRITE_MAP", "1=1s,+1=1m")
+
+			Expect(func() {
+				ferrite.
+					MapOf[int, time.Duration]("FERRITE_MAP", "<desc>", Signed[int]("", ""), Duration("", "")).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_MAP (1=1s,+1=1m) is invalid: key +1 is specified more than once`,
+			))
+		})
+	})
+})
+
+func ExampleMap_required() {
+	defer example()()
+
+	v := ferrite.
+		Map("FERRITE_MAP", "example map variable").
+		Required()
+
+	os.Setenv("FERRITE_MAP", "region=us-east-1, tier=gold")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is map[region:us-east-1 tier:gold]
+}
+
+func ExampleMap_default() {
+	defer example()()
+
+	v := ferrite.
+		Map("FERRITE_MAP", "example map variable").
+		WithDefault(map[string]string{"region": "us-east-1"}).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is map[region:us-east-1]
+}
+
+func ExampleMap_optional() {
+	defer example()()
+
+	v := ferrite.
+		Map("FERRITE_MAP", "example map variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleMapOf() {
+	defer example()()
+
+	v := ferrite.
+		MapOf[string, time.Duration](
+		"FERRITE_MAP",
+		"example map variable",
+		ferrite.String("", ""),
+		ferrite.Duration("", ""),
+	).
+		WithAllowedKeys("read", "write").
+		WithRequiredKeys("read").
+		Required()
+
+	os.Setenv("FERRITE_MAP", "read=5s,write=1m")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is map[read:5s write:1m0s]
+}
+
+func ExampleMap_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_MAP", "region = us-east-1")
+	v := ferrite.
+		Map("FERRITE_MAP", "example map variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_MAP  example map variable  [ <string>=<string>, ... ]  ⚠ deprecated variable set to 'region = us-east-1', equivalent to region=us-east-1
+	//
+	// value is map[region:us-east-1]
+}
+
+func ExampleMap_sensitive() {
+	defer example()()
+
+	os.Setenv("FERRITE_MAP_PAIR", "a=secret1,broken-secret2")
+	ferrite.
+		Map("FERRITE_MAP_PAIR", "example sensitive map variable").
+		WithSensitiveContent().
+		Required()
+
+	os.Setenv("FERRITE_MAP_VALUE", "a=1,b=hunter2")
+	ferrite.
+		MapOf[string, int](
+		"FERRITE_MAP_VALUE",
+		"example sensitive map variable",
+		ferrite.String("", ""),
+		ferrite.Signed[int]("", ""),
+	).
+		WithSensitiveContent().
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_MAP_PAIR   example sensitive map variable    <string>=<string>, ...    ✗ set to ************************, pair #2 is missing the "=" separator
+	//  ❯ FERRITE_MAP_VALUE  example sensitive map variable    <string>=<int>, ...       ✗ set to *************, value of key b is invalid, expected integer
+	//
+	// <process exited with error code 1>
+}
@@ -0,0 +1,229 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// NetworkAddressValue is a network address consisting of a host and a port.
+type NetworkAddressValue struct {
+	Host string
+	Port string
+}
+
+func (a NetworkAddressValue) String() string {
+	return net.JoinHostPort(a.Host, a.Port)
+}
+
+// NetworkAddress configures an environment variable as a network address
+// consisting of a host and a port, such as "example.org:443".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// The host may be a hostname or an IP address. IPv6 addresses must be enclosed
+// in square brackets, such as "[2001:db8::1]:443". The port may be a numeric
+// port or an IANA service name.
+func NetworkAddress(name, desc string) *NetworkAddressBuilder {
+	b := &NetworkAddressBuilder{
+		schema: variable.TypedOther[NetworkAddressValue]{
+			Marshaler: networkAddressMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.BuiltInConstraint(
+		"**MUST** be a valid network address",
+		func(v NetworkAddressValue) variable.ConstraintError {
+			if err := validateHost(v.Host); err != nil {
+				return err
+			}
+			return validatePort(v.Port)
+		},
+	)
+	b.builder.NonNormativeExample(
+		NetworkAddressValue{"example.org", "443"},
+		"a hostname and numeric port",
+	)
+	b.builder.NonNormativeExample(
+		NetworkAddressValue{"2001:db8::1", "https"},
+		"an IPv6 address and IANA service name",
+	)
+
+	return b
+}
+
+// NetworkAddressBuilder builds a specification for a network address variable.
+type NetworkAddressBuilder struct {
+	schema    variable.TypedOther[NetworkAddressValue]
+	builder   variable.TypedSpecBuilder[NetworkAddressValue]
+	def       maybe.Value[string]
+	completed bool
+}
+
+var _ isBuilderOf[NetworkAddressValue, *NetworkAddressBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. v may omit
+// the port if a default port has been set using WithDefaultPort().
+func (b *NetworkAddressBuilder) WithDefault(v string) *NetworkAddressBuilder {
+	b.def = maybe.Some(v)
+	return b
+}
+
+// WithDefaultPort sets the port to use when the value does not include one.
+//
+// IPv6 addresses must still be enclosed in square brackets, such as `[::1]`.
+func (b *NetworkAddressBuilder) WithDefaultPort(port string) *NetworkAddressBuilder {
+	if err := validatePort(port); err != nil {
+		panic(fmt.Sprintf("default port is invalid: %s", err))
+	}
+
+	b.schema.Marshaler = networkAddressMarshaler{
+		DefaultPort: port,
+	}
+
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *NetworkAddressBuilder) Required(options ...RequiredOption) Required[NetworkAddressValue] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *NetworkAddressBuilder) Optional(options ...OptionalOption) Optional[NetworkAddressValue] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *NetworkAddressBuilder) Deprecated(options ...DeprecatedOption) Deprecated[NetworkAddressValue] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *NetworkAddressBuilder) element() (variable.TypedSchema[NetworkAddressValue], *variable.TypedSpecBuilder[NetworkAddressValue]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete sets the default value and adds documentation describing the
+// network address syntax.
+//
+// It is called when the build process is complete, as the default port may be
+// changed at any point before then.
+//
+// It has no effect if it has already been called.
+func (b *NetworkAddressBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	if v, ok := b.def.Get(); ok {
+		a, err := b.schema.Marshaler.Unmarshal(variable.Literal{String: v})
+		if err != nil {
+			panic(fmt.Sprintf("default value is invalid: %s", err))
+		}
+		b.builder.Default(a)
+	}
+
+	d := b.builder.Documentation().
+		Summary("Network address syntax").
+		Paragraph(
+			"Network addresses are specified as a host and port separated by a colon,",
+			"such as `example.org:443`.",
+			"The host may be a hostname or an IP address.",
+			"IPv6 addresses must be enclosed in square brackets,",
+			"such as `[2001:db8::1]:443`.",
+		).
+		Format()
+
+	if port := b.schema.Marshaler.(networkAddressMarshaler).DefaultPort; port != "" {
+		d = d.
+			Paragraph(
+				"The port may be omitted, in which case the default port of `%s` is used.",
+			).
+			Format(port)
+	}
+
+	d.Done()
+
+	buildNetworkPortSyntaxDocumentation(b.builder.Documentation())
+}
+
+type networkAddressMarshaler struct {
+	DefaultPort string
+}
+
+func (m networkAddressMarshaler) Marshal(v NetworkAddressValue) (variable.Literal, error) {
+	if v == (NetworkAddressValue{}) {
+		return variable.Literal{}, nil
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (m networkAddressMarshaler) Unmarshal(v variable.Literal) (NetworkAddressValue, error) {
+	if m.DefaultPort != "" && !hasPort(v.String) {
+		host := v.String
+
+		if strings.HasPrefix(host, "[") {
+			// A bracketed IPv6 address must be closed, and must not be
+			// followed by anything other than a port.
+			i := strings.IndexByte(host, ']')
+			if i == -1 {
+				return NetworkAddressValue{}, errors.New("missing ']' in address")
+			}
+			if i != len(host)-1 {
+				return NetworkAddressValue{}, errors.New("unexpected characters after ']' in address")
+			}
+			host = host[1:i]
+		}
+
+		return NetworkAddressValue{
+			Host: host,
+			Port: m.DefaultPort,
+		}, nil
+	}
+
+	host, port, err := net.SplitHostPort(v.String)
+	if err != nil {
+		var addrErr *net.AddrError
+		if errors.As(err, &addrErr) {
+			return NetworkAddressValue{}, errors.New(addrErr.Err)
+		}
+		return NetworkAddressValue{}, err
+	}
+
+	return NetworkAddressValue{host, port}, nil
+}
+
+// hasPort returns true if addr appears to include a port.
+func hasPort(addr string) bool {
+	if strings.HasPrefix(addr, "[") {
+		// A bracketed IPv6 address, which only has a port if there is a colon
+		// after the closing bracket.
+		i := strings.LastIndexByte(addr, ']')
+		return i != -1 && strings.Contains(addr[i:], ":")
+	}
+
+	// Any other colon is treated as a port separator, so that an unbracketed
+	// IPv6 address is rejected by net.SplitHostPort() rather than being
+	// mistaken for a host without a port.
+	return strings.Contains(addr, ":")
+}
@@ -0,0 +1,316 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type NetworkAddressBuilder", func() {
+	var builder *NetworkAddressBuilder
+
+	BeforeEach(func() {
+		builder = NetworkAddress("FERRITE_NETWORK_ADDRESS", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			NetworkAddress("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			NetworkAddress("FERRITE_NETWORK_ADDRESS", "").Optional()
+		}).To(PanicWith("specification for FERRITE_NETWORK_ADDRESS is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		Expect(func() {
+			builder.
+				WithDefault("localhost").
+				Required()
+		}).To(PanicWith("default value is invalid: missing port in address"))
+	})
+
+	It("panics if the default port is invalid", func() {
+		Expect(func() {
+			builder.WithDefaultPort("65536")
+		}).To(PanicWith("default port is invalid: numeric ports must be between 1 and 65535"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid address", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect NetworkAddressValue) {
+						os.Setenv("FERRITE_NETWORK_ADDRESS", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("hostname", "redis:6379", NetworkAddressValue{"redis", "6379"}),
+					Entry("IPv4 address", "192.0.2.1:6379", NetworkAddressValue{"192.0.2.1", "6379"}),
+					Entry("IPv6 address", "[2001:db8::1]:6379", NetworkAddressValue{"2001:db8::1", "6379"}),
+					Entry("IANA service name", "example.org:https", NetworkAddressValue{"example.org", "https"}),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_NETWORK_ADDRESS", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing port",
+						"redis",
+						`value of FERRITE_NETWORK_ADDRESS (redis) is invalid: missing port in address`,
+					),
+					Entry(
+						"unbracketed IPv6 address",
+						"2001:db8::1:6379",
+						`value of FERRITE_NETWORK_ADDRESS (2001:db8::1:6379) is invalid: too many colons in address`,
+					),
+					Entry(
+						"empty host",
+						":6379",
+						`value of FERRITE_NETWORK_ADDRESS (:6379) is invalid: host must not be empty`,
+					),
+					Entry(
+						"invalid host",
+						".redis:6379",
+						`value of FERRITE_NETWORK_ADDRESS (.redis:6379) is invalid: host must not begin or end with a dot`,
+					),
+					Entry(
+						"invalid port",
+						"redis:65536",
+						`value of FERRITE_NETWORK_ADDRESS (redis:65536) is invalid: numeric ports must be between 1 and 65535`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("localhost:6379").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(NetworkAddressValue{"localhost", "6379"}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_NETWORK_ADDRESS is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("there is a default port", func() {
+		DescribeTable(
+			"it uses the default port if the value does not include one",
+			func(value string, expect NetworkAddressValue) {
+				os.Setenv("FERRITE_NETWORK_ADDRESS", value)
+
+				v := builder.
+					WithDefaultPort("6379").
+					Required().
+					Value()
+
+				Expect(v).To(Equal(expect))
+			},
+			Entry("hostname", "redis", NetworkAddressValue{"redis", "6379"}),
+			Entry("hostname with port", "redis:6380", NetworkAddressValue{"redis", "6380"}),
+			Entry("bracketed IPv6 address", "[2001:db8::1]", NetworkAddressValue{"2001:db8::1", "6379"}),
+			Entry("bracketed IPv6 address with port", "[2001:db8::1]:6380", NetworkAddressValue{"2001:db8::1", "6380"}),
+		)
+
+		DescribeTable(
+			"it panics if an IPv6 address is malformed",
+			func(value, expect string) {
+				os.Setenv("FERRITE_NETWORK_ADDRESS", value)
+
+				Expect(func() {
+					builder.
+						WithDefaultPort("6379").
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"unclosed bracket",
+				"[::1",
+				`value of FERRITE_NETWORK_ADDRESS ('[::1') is invalid: missing ']' in address`,
+			),
+			Entry(
+				"characters after the closing bracket",
+				"[::1]x",
+				`value of FERRITE_NETWORK_ADDRESS ('[::1]x') is invalid: unexpected characters after ']' in address`,
+			),
+			Entry(
+				"unbracketed IPv6 address",
+				"::1",
+				`value of FERRITE_NETWORK_ADDRESS (::1) is invalid: too many colons in address`,
+			),
+			Entry(
+				"unbracketed IPv6 address with port",
+				"::1:8080",
+				`value of FERRITE_NETWORK_ADDRESS (::1:8080) is invalid: too many colons in address`,
+			),
+		)
+
+		It("uses the default port for a default value that does not include one", func() {
+			v := builder.
+				WithDefault("localhost").
+				WithDefaultPort("6379").
+				Required().
+				Value()
+
+			Expect(v).To(Equal(NetworkAddressValue{"localhost", "6379"}))
+		})
+	})
+})
+
+func ExampleNetworkAddress_required() {
+	defer example()()
+
+	v := ferrite.
+		NetworkAddress("FERRITE_NETWORK_ADDRESS", "example network address variable").
+		Required()
+
+	os.Setenv("FERRITE_NETWORK_ADDRESS", "redis:6379")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is redis:6379
+}
+
+func ExampleNetworkAddress_default() {
+	defer example()()
+
+	v := ferrite.
+		NetworkAddress("FERRITE_NETWORK_ADDRESS", "example network address variable").
+		WithDefault("localhost:6379").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is localhost:6379
+}
+
+func ExampleNetworkAddress_optional() {
+	defer example()()
+
+	v := ferrite.
+		NetworkAddress("FERRITE_NETWORK_ADDRESS", "example network address variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleNetworkAddress_defaultPort() {
+	defer example()()
+
+	v := ferrite.
+		NetworkAddress("FERRITE_NETWORK_ADDRESS", "example network address variable").
+		WithDefaultPort("6379").
+		Required()
+
+	os.Setenv("FERRITE_NETWORK_ADDRESS", "redis")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is redis:6379
+}
+
+func ExampleNetworkAddress_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_NETWORK_ADDRESS", "[2001:db8::1]")
+	v := ferrite.
+		NetworkAddress("FERRITE_NETWORK_ADDRESS", "example network address variable").
+		WithDefaultPort("6379").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_NETWORK_ADDRESS  example network address variable  [ <string> ]  ⚠ deprecated variable set to '[2001:db8::1]', equivalent to '[2001:db8::1]:6379'
+	//
+	// value is [2001:db8::1]:6379
+}
@@ -63,6 +63,10 @@ func (b *NetworkPortBuilder) Deprecated(options ...DeprecatedOption) Deprecated[
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *NetworkPortBuilder) element() (variable.TypedSchema[string], *variable.TypedSpecBuilder[string]) {
+	return b.schema, &b.builder
+}
+
 // validateHost returns an error of port is not a valid numeric port or IANA
 // service name.
 func validatePort(port string) error {
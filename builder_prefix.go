@@ -0,0 +1,218 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Prefix configures an environment variable as an IP prefix (also known as a
+// network or subnet) in CIDR notation, such as "192.0.2.0/24".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// Both IPv4 and IPv6 prefixes are accepted by default.
+func Prefix(name, desc string) *PrefixBuilder {
+	b := &PrefixBuilder{
+		schema: variable.TypedOther[netip.Prefix]{
+			Marshaler: prefixMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// PrefixBuilder builds a specification for an IP prefix variable.
+type PrefixBuilder struct {
+	schema    variable.TypedOther[netip.Prefix]
+	builder   variable.TypedSpecBuilder[netip.Prefix]
+	ip        ipRestrictions
+	completed bool
+}
+
+var _ isBuilderOf[netip.Prefix, *PrefixBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *PrefixBuilder) WithDefault(v string) *PrefixBuilder {
+	b.builder.Default(netip.MustParsePrefix(v))
+	return b
+}
+
+// WithIPv4Only restricts the variable to IPv4 prefixes.
+func (b *PrefixBuilder) WithIPv4Only() *PrefixBuilder {
+	b.ip.Family = 4
+	return b
+}
+
+// WithIPv6Only restricts the variable to IPv6 prefixes.
+func (b *PrefixBuilder) WithIPv6Only() *PrefixBuilder {
+	b.ip.Family = 6
+	return b
+}
+
+// WithoutLoopback forbids prefixes of loopback addresses, such as 127.0.0.0/8
+// and ::1/128.
+func (b *PrefixBuilder) WithoutLoopback() *PrefixBuilder {
+	b.ip.NoLoopback = true
+	return b
+}
+
+// WithoutUnspecified forbids prefixes of the unspecified addresses, such as
+// 0.0.0.0/0 and ::/0.
+func (b *PrefixBuilder) WithoutUnspecified() *PrefixBuilder {
+	b.ip.NoUnspecified = true
+	return b
+}
+
+// WithCanonicalForm normalizes the prefix to its canonical form by clearing
+// any bits of the address beyond the prefix length.
+//
+// For example, "192.0.2.1/24" is normalized to "192.0.2.0/24".
+func (b *PrefixBuilder) WithCanonicalForm() *PrefixBuilder {
+	b.schema.Marshaler = prefixMarshaler{
+		Canonical: true,
+	}
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *PrefixBuilder) Required(options ...RequiredOption) Required[netip.Prefix] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *PrefixBuilder) Optional(options ...OptionalOption) Optional[netip.Prefix] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *PrefixBuilder) Deprecated(options ...DeprecatedOption) Deprecated[netip.Prefix] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *PrefixBuilder) element() (variable.TypedSchema[netip.Prefix], *variable.TypedSpecBuilder[netip.Prefix]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints, examples and documentation that depend on the
+// builder's options.
+//
+// It has no effect if it has already been called.
+func (b *PrefixBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	b.builder.BuiltInConstraint(
+		"**MUST** be "+b.ip.describe("prefix"),
+		func(v netip.Prefix) variable.ConstraintError {
+			return b.ip.check(v.Addr(), "prefix")
+		},
+	)
+
+	if b.ip.Family != 6 {
+		b.builder.NonNormativeExample(
+			netip.MustParsePrefix("192.0.2.0/24"),
+			"an IPv4 network reserved for documentation",
+		)
+	}
+
+	if b.ip.Family != 4 {
+		b.builder.NonNormativeExample(
+			netip.MustParsePrefix("2001:db8::/32"),
+			"an IPv6 network reserved for documentation",
+		)
+	}
+
+	m := b.schema.Marshaler.(prefixMarshaler)
+	buildPrefixSyntaxDocumentation(b.builder.Documentation(), b.ip.Family, m.Canonical)
+}
+
+type prefixMarshaler struct {
+	Canonical bool
+}
+
+func (m prefixMarshaler) Marshal(v netip.Prefix) (variable.Literal, error) {
+	if !v.IsValid() {
+		return variable.Literal{}, nil
+	}
+
+	if m.Canonical {
+		v = v.Masked()
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (m prefixMarshaler) Unmarshal(v variable.Literal) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(v.String)
+	if err != nil {
+		return netip.Prefix{}, errors.New(
+			strings.TrimPrefix(
+				err.Error(),
+				fmt.Sprintf("netip.ParsePrefix(%q): ", v.String),
+			),
+		)
+	}
+
+	if m.Canonical {
+		p = p.Masked()
+	}
+
+	return p, nil
+}
+
+func buildPrefixSyntaxDocumentation(d variable.DocumentationBuilder, family int, canonical bool) {
+	example := "`192.0.2.0/24` or `2001:db8::/32`"
+
+	switch family {
+	case 4:
+		example = "`192.0.2.0/24`"
+	case 6:
+		example = "`2001:db8::/32`"
+	}
+
+	d = d.
+		Summary("IP prefix syntax").
+		Paragraph(
+			"Prefixes are specified in CIDR notation,",
+			"which is an IP address followed by a slash and the number of leading bits that identify the network,",
+			"such as %s.",
+		).
+		Format(example)
+
+	if canonical {
+		before, after := "192.0.2.1/24", "192.0.2.0/24"
+		if family == 6 {
+			before, after = "2001:db8::1/32", "2001:db8::/32"
+		}
+
+		d = d.
+			Paragraph(
+				"Any bits of the address beyond the prefix length are cleared,",
+				"such that `%s` is equivalent to `%s`.",
+			).
+			Format(before, after)
+	}
+
+	d.Done()
+}
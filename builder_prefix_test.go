@@ -0,0 +1,269 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type PrefixBuilder", func() {
+	var builder *PrefixBuilder
+
+	BeforeEach(func() {
+		builder = Prefix("FERRITE_PREFIX", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Prefix("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Prefix("FERRITE_PREFIX", "").Optional()
+		}).To(PanicWith("specification for FERRITE_PREFIX is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid prefix", func() {
+			Describe("func Value()", func() {
+				It("returns the value", func() {
+					os.Setenv("FERRITE_PREFIX", "192.0.2.1/24")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(Equal(netip.MustParsePrefix("192.0.2.1/24")))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_PREFIX", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing prefix length",
+						"192.0.2.0",
+						`value of FERRITE_PREFIX (192.0.2.0) is invalid: no '/'`,
+					),
+					Entry(
+						"prefix length out of range",
+						"192.0.2.0/33",
+						`value of FERRITE_PREFIX (192.0.2.0/33) is invalid: prefix length out of range`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("10.0.0.0/8").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(netip.MustParsePrefix("10.0.0.0/8")))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_PREFIX is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the prefix is normalized to its canonical form", func() {
+		It("clears the host bits", func() {
+			os.Setenv("FERRITE_PREFIX", "192.0.2.1/24")
+
+			v := builder.
+				WithCanonicalForm().
+				Required().
+				Value()
+
+			Expect(v).To(Equal(netip.MustParsePrefix("192.0.2.0/24")))
+		})
+	})
+
+	When("the prefix is restricted", func() {
+		DescribeTable(
+			"it panics if the value does not meet the restrictions",
+			func(value string, restrict func(*PrefixBuilder) *PrefixBuilder, expect string) {
+				os.Setenv("FERRITE_PREFIX", value)
+
+				Expect(func() {
+					restrict(builder).
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"IPv4 only",
+				"2001:db8::/32",
+				(*PrefixBuilder).WithIPv4Only,
+				`value of FERRITE_PREFIX (2001:db8::/32) is invalid: expected an IPv4 prefix`,
+			),
+			Entry(
+				"IPv6 only",
+				"192.0.2.0/24",
+				(*PrefixBuilder).WithIPv6Only,
+				`value of FERRITE_PREFIX (192.0.2.0/24) is invalid: expected an IPv6 prefix`,
+			),
+			Entry(
+				"loopback forbidden",
+				"127.0.0.0/8",
+				(*PrefixBuilder).WithoutLoopback,
+				`value of FERRITE_PREFIX (127.0.0.0/8) is invalid: prefix must not be a loopback address`,
+			),
+			Entry(
+				"unspecified forbidden",
+				"0.0.0.0/0",
+				(*PrefixBuilder).WithoutUnspecified,
+				`value of FERRITE_PREFIX (0.0.0.0/0) is invalid: prefix must not be an unspecified address`,
+			),
+		)
+	})
+})
+
+func ExamplePrefix_required() {
+	defer example()()
+
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example IP prefix variable").
+		Required()
+
+	os.Setenv("FERRITE_PREFIX", "192.0.2.0/24")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 192.0.2.0/24
+}
+
+func ExamplePrefix_default() {
+	defer example()()
+
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example IP prefix variable").
+		WithDefault("10.0.0.0/8").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 10.0.0.0/8
+}
+
+func ExamplePrefix_optional() {
+	defer example()()
+
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example IP prefix variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExamplePrefix_canonical() {
+	defer example()()
+
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example IP prefix variable").
+		WithCanonicalForm().
+		Required()
+
+	os.Setenv("FERRITE_PREFIX", "192.0.2.1/24")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 192.0.2.0/24
+}
+
+func ExamplePrefix_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_PREFIX", "192.0.2.1/24")
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example IP prefix variable").
+		WithCanonicalForm().
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_PREFIX  example IP prefix variable  [ <string> ]  ⚠ deprecated variable set to 192.0.2.1/24, equivalent to 192.0.2.0/24
+	//
+	// value is 192.0.2.0/24
+}
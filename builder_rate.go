@@ -0,0 +1,382 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Rate configures an environment variable as a rate, such as a rate limit,
+// expressed as a number of events per time window.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// Rates may be specified as "<count>/<window>" or "<count> per <window>", such
+// as "100/s", "5000/1m" or "10 per hour". The count must be at least 1 unless
+// a minimum rate is set using WithMinimumPerSecond().
+func Rate(name, desc string) *RateBuilder {
+	b := &RateBuilder{
+		schema: variable.TypedOther[RateValue]{
+			Marshaler: rateMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Rate syntax").
+		Paragraph(
+			"Rates are specified as a whole number of events per time window,",
+			"using either `<count>/<window>` or `<count> per <window>`,",
+			"such as `100/s`, `5000/1m` or `10 per hour`.",
+		).
+		Format().
+		Paragraph(
+			"The window may be a unit on its own, such as `s` or `minute`, which is equivalent to one of that unit.",
+			"It may also be a number followed by a unit, such as `5m` or `30 seconds`,",
+			"or any duration accepted by Go's `time.ParseDuration()`, such as `1m30s`.",
+			"Supported units are `s` (or `sec`, `second`), `m` (or `min`, `minute`), `h` (or `hr`, `hour`) and `d` (or `day`), where a day is always 24 hours.",
+			"Unit names are not case-sensitive and may be pluralized.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// RateBuilder builds a specification for a rate variable.
+type RateBuilder struct {
+	schema    variable.TypedOther[RateValue]
+	builder   variable.TypedSpecBuilder[RateValue]
+	min, max  maybe.Value[float64]
+	completed bool
+}
+
+var _ isBuilderOf[RateValue, *RateBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. It panics if
+// the window is not positive.
+func (b *RateBuilder) WithDefault(count uint64, window time.Duration) *RateBuilder {
+	if window <= 0 {
+		panic(fmt.Sprintf("rate window (%s) must be positive", window))
+	}
+
+	b.builder.Default(RateValue{count, window})
+	return b
+}
+
+// WithMinimumPerSecond sets the minimum acceptable rate, expressed as a number
+// of events per second.
+//
+// The limit applies to the effective rate, regardless of the window used to
+// specify the value. For example, a minimum of 1 per second accepts "60/m" but
+// not "59/m".
+//
+// Setting a minimum replaces the requirement that the count is at least 1, so
+// a minimum of 0 permits a rate with a count of 0, such as "0/s".
+func (b *RateBuilder) WithMinimumPerSecond(v float64) *RateBuilder {
+	if v < 0 {
+		panic(fmt.Sprintf("minimum rate (%v per second) must not be negative", v))
+	}
+
+	b.min = maybe.Some(v)
+	return b
+}
+
+// WithMaximumPerSecond sets the maximum acceptable rate, expressed as a number
+// of events per second.
+//
+// The limit applies to the effective rate, regardless of the window used to
+// specify the value. For example, a maximum of 1 per second accepts "60/m" but
+// not "61/m".
+func (b *RateBuilder) WithMaximumPerSecond(v float64) *RateBuilder {
+	if v < 0 {
+		panic(fmt.Sprintf("maximum rate (%v per second) must not be negative", v))
+	}
+
+	b.max = maybe.Some(v)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *RateBuilder) Required(options ...RequiredOption) Required[RateValue] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *RateBuilder) Optional(options ...OptionalOption) Optional[RateValue] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *RateBuilder) Deprecated(options ...DeprecatedOption) Deprecated[RateValue] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *RateBuilder) element() (variable.TypedSchema[RateValue], *variable.TypedSpecBuilder[RateValue]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints and examples that depend on the builder's
+// options.
+//
+// The examples are the first three of a few common rates that fall within the
+// permitted range.
+//
+// It has no effect if it has already been called.
+func (b *RateBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	min, hasMin := b.min.Get()
+	max, hasMax := b.max.Get()
+
+	if hasMin && hasMax && min > max {
+		panic(fmt.Sprintf(
+			"minimum rate (%s) must not be greater than the maximum rate (%s)",
+			formatPerSecond(min),
+			formatPerSecond(max),
+		))
+	}
+
+	var (
+		req      = "**MUST** be a valid rate with a count of at least 1"
+		expected string
+	)
+
+	switch {
+	case hasMin && hasMax:
+		req = fmt.Sprintf("**MUST** be between `%s` and `%s`", formatPerSecond(min), formatPerSecond(max))
+		expected = fmt.Sprintf("expected between %s and %s", formatPerSecond(min), formatPerSecond(max))
+	case hasMin:
+		req = fmt.Sprintf("**MUST** be `%s` or greater", formatPerSecond(min))
+		expected = fmt.Sprintf("expected %s or greater", formatPerSecond(min))
+	case hasMax:
+		req = fmt.Sprintf("**MUST** be `%s` or less, with a count of at least 1", formatPerSecond(max))
+		expected = fmt.Sprintf("expected %s or less", formatPerSecond(max))
+	}
+
+	b.builder.BuiltInConstraint(
+		req,
+		func(v RateValue) variable.ConstraintError {
+			if !hasMin && v.Count < 1 {
+				return errors.New("count must be at least 1")
+			}
+
+			r := v.PerSecond()
+
+			if hasMin && r < min {
+				return errors.New("too low, " + expected)
+			}
+
+			if hasMax && r > max {
+				return errors.New("too high, " + expected)
+			}
+
+			return nil
+		},
+	)
+
+	n := 0
+	for _, eg := range []struct {
+		Value       RateValue
+		Description string
+	}{
+		{RateValue{100, time.Second}, "100 events per second"},
+		{RateValue{5000, time.Minute}, "5,000 events per minute"},
+		{RateValue{10, time.Hour}, "10 events per hour"},
+		{RateValue{1000, 24 * time.Hour}, "1,000 events per day"},
+		{RateValue{10000, time.Second}, "10,000 events per second"},
+	} {
+		r := eg.Value.PerSecond()
+
+		if hasMin && r < min {
+			continue
+		}
+
+		if hasMax && r > max {
+			continue
+		}
+
+		b.builder.NonNormativeExample(eg.Value, eg.Description)
+
+		if n++; n == 3 {
+			break
+		}
+	}
+}
+
+// RateValue is a number of events per time window.
+type RateValue struct {
+	// Count is the number of events permitted within the window.
+	Count uint64
+
+	// Window is the period of time over which the events are counted. It is
+	// always positive.
+	Window time.Duration
+}
+
+// PerSecond returns the effective rate, expressed as a number of events per
+// second.
+func (r RateValue) PerSecond() float64 {
+	return float64(r.Count) / r.Window.Seconds()
+}
+
+// String returns the canonical representation of the rate, such as "100/s" or
+// "5000/m".
+func (r RateValue) String() string {
+	return strconv.FormatUint(r.Count, 10) + "/" + formatRateWindow(r.Window)
+}
+
+// formatRateWindow returns the canonical representation of a rate window.
+//
+// Windows of exactly one second, minute, hour or day are represented by the
+// unit alone.
+func formatRateWindow(d time.Duration) string {
+	for _, u := range []struct {
+		Symbol string
+		Size   time.Duration
+	}{
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	} {
+		if d == u.Size {
+			return u.Symbol
+		}
+
+		if d%u.Size == 0 {
+			return strconv.FormatInt(int64(d/u.Size), 10) + u.Symbol
+		}
+	}
+
+	return d.String()
+}
+
+// formatPerSecond returns a human-readable representation of a rate expressed
+// as a number of events per second.
+func formatPerSecond(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64) + "/s"
+}
+
+// rateUnits is a map of the unit names accepted within a rate window to the
+// duration they represent.
+var rateUnits = map[string]time.Duration{
+	"s":       time.Second,
+	"sec":     time.Second,
+	"secs":    time.Second,
+	"second":  time.Second,
+	"seconds": time.Second,
+	"m":       time.Minute,
+	"min":     time.Minute,
+	"mins":    time.Minute,
+	"minute":  time.Minute,
+	"minutes": time.Minute,
+	"h":       time.Hour,
+	"hr":      time.Hour,
+	"hrs":     time.Hour,
+	"hour":    time.Hour,
+	"hours":   time.Hour,
+	"d":       24 * time.Hour,
+	"day":     24 * time.Hour,
+	"days":    24 * time.Hour,
+}
+
+// ratePattern matches the rate syntaxes, capturing the count and the window.
+var ratePattern = regexp.MustCompile(`(?i)^(\d+)\s*(?:/|\s+per\s+)\s*(\S.*)$`)
+
+// rateWindowPattern matches a rate window that consists of an optional whole
+// number followed by a unit name.
+var rateWindowPattern = regexp.MustCompile(`^(\d*)\s*([a-zA-Z]+)$`)
+
+type rateMarshaler struct{}
+
+func (rateMarshaler) Marshal(v RateValue) (variable.Literal, error) {
+	if v.Window <= 0 {
+		return variable.Literal{}, fmt.Errorf("rate window (%s) must be positive", v.Window)
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (rateMarshaler) Unmarshal(v variable.Literal) (RateValue, error) {
+	m := ratePattern.FindStringSubmatch(strings.TrimSpace(v.String))
+	if m == nil {
+		return RateValue{}, errors.New("expected a rate such as 100/s, 5000/1m or 10 per hour")
+	}
+
+	count, err := strconv.ParseUint(m[1], 10, 64)
+	if err != nil {
+		return RateValue{}, fmt.Errorf("invalid count (%s)", m[1])
+	}
+
+	window, err := parseRateWindow(strings.TrimSpace(m[2]))
+	if err != nil {
+		return RateValue{}, err
+	}
+
+	return RateValue{count, window}, nil
+}
+
+// parseRateWindow parses the window component of a rate.
+func parseRateWindow(s string) (time.Duration, error) {
+	literal := s
+
+	if m := rateWindowPattern.FindStringSubmatch(s); m != nil {
+		if unit, ok := rateUnits[strings.ToLower(m[2])]; ok {
+			n := int64(1)
+
+			if m[1] != "" {
+				var err error
+				n, err = strconv.ParseInt(m[1], 10, 64)
+				if err != nil || n > int64(maxDuration/unit) {
+					return 0, fmt.Errorf("invalid window (%s)", s)
+				}
+			}
+
+			if n == 0 {
+				return 0, fmt.Errorf("window (%s) must be positive", s)
+			}
+
+			return time.Duration(n) * unit, nil
+		}
+
+		if m[1] == "" {
+			// Allow other units accepted by time.ParseDuration(), such as "ms",
+			// to be used without a number.
+			s = "1" + s
+		}
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid window (%s)", literal)
+	}
+
+	if d <= 0 {
+		return 0, fmt.Errorf("window (%s) must be positive", literal)
+	}
+
+	return d, nil
+}
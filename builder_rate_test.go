@@ -0,0 +1,385 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type RateBuilder", func() {
+	var builder *RateBuilder
+
+	BeforeEach(func() {
+		builder = Rate("FERRITE_RATE", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Rate("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Rate("FERRITE_RATE", "").Optional()
+		}).To(PanicWith("specification for FERRITE_RATE is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default window is not positive", func() {
+		Expect(func() {
+			builder.WithDefault(100, 0)
+		}).To(PanicWith("rate window (0s) must be positive"))
+	})
+
+	It("panics if the minimum is negative", func() {
+		Expect(func() {
+			builder.WithMinimumPerSecond(-1)
+		}).To(PanicWith("minimum rate (-1 per second) must not be negative"))
+	})
+
+	It("panics if the maximum is negative", func() {
+		Expect(func() {
+			builder.WithMaximumPerSecond(-1)
+		}).To(PanicWith("maximum rate (-1 per second) must not be negative"))
+	})
+
+	It("panics if the minimum is greater than the maximum", func() {
+		Expect(func() {
+			builder.
+				WithMinimumPerSecond(10).
+				WithMaximumPerSecond(1).
+				Required()
+		}).To(PanicWith("minimum rate (10/s) must not be greater than the maximum rate (1/s)"))
+	})
+
+	It("panics if the default value is out of range", func() {
+		Expect(func() {
+			builder.
+				WithDefault(10, time.Second).
+				WithMaximumPerSecond(1).
+				Required()
+		}).To(PanicWith("specification for FERRITE_RATE is invalid: default value: too high, expected 1/s or less"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid rate", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect RateValue, canonical string) {
+						os.Setenv("FERRITE_RATE", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+						Expect(v.String()).To(Equal(canonical))
+					},
+					Entry("per unit", "100/s", RateValue{100, time.Second}, "100/s"),
+					Entry("per duration", "5000/1m", RateValue{5000, time.Minute}, "5000/m"),
+					Entry("per multiple units", "30/5m", RateValue{30, 5 * time.Minute}, "30/5m"),
+					Entry("per compound duration", "3/1m30s", RateValue{3, 90 * time.Second}, "3/90s"),
+					Entry("per sub-second duration", "1/500ms", RateValue{1, 500 * time.Millisecond}, "1/500ms"),
+					Entry("per day", "1000/d", RateValue{1000, 24 * time.Hour}, "1000/d"),
+					Entry("per word", "10 per hour", RateValue{10, time.Hour}, "10/h"),
+					Entry("per number and word", "10 per 30 seconds", RateValue{10, 30 * time.Second}, "10/30s"),
+					Entry("uppercase", "10 PER MINUTE", RateValue{10, time.Minute}, "10/m"),
+					Entry("whitespace around slash", "10 / min", RateValue{10, time.Minute}, "10/m"),
+				)
+
+				It("returns the effective rate per second", func() {
+					os.Setenv("FERRITE_RATE", "5000/1m")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v.PerSecond()).To(BeNumerically("~", 83.333, 0.001))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_RATE", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing window",
+						"100",
+						`value of FERRITE_RATE (100) is invalid: expected a rate such as 100/s, 5000/1m or 10 per hour`,
+					),
+					Entry(
+						"negative count",
+						"-1/s",
+						`value of FERRITE_RATE (-1/s) is invalid: expected a rate such as 100/s, 5000/1m or 10 per hour`,
+					),
+					Entry(
+						"zero count",
+						"0/s",
+						`value of FERRITE_RATE (0/s) is invalid: count must be at least 1`,
+					),
+					Entry(
+						"fractional count",
+						"1.5/s",
+						`value of FERRITE_RATE (1.5/s) is invalid: expected a rate such as 100/s, 5000/1m or 10 per hour`,
+					),
+					Entry(
+						"unknown unit",
+						"10/fortnight",
+						`value of FERRITE_RATE (10/fortnight) is invalid: invalid window (fortnight)`,
+					),
+					Entry(
+						"zero window",
+						"10/0s",
+						`value of FERRITE_RATE (10/0s) is invalid: window (0s) must be positive`,
+					),
+					Entry(
+						"negative window",
+						"10/-1s",
+						`value of FERRITE_RATE (10/-1s) is invalid: window (-1s) must be positive`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(100, time.Second).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(RateValue{100, time.Second}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_RATE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the value is lower than the minimum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_RATE", "59/m")
+
+				builder.
+					WithMinimumPerSecond(1).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_RATE (59/m) is invalid: too low, expected 1/s or greater`,
+			))
+		})
+	})
+
+	When("the value is greater than the maximum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_RATE", "61/m")
+
+				builder.
+					WithMinimumPerSecond(0.5).
+					WithMaximumPerSecond(1).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_RATE (61/m) is invalid: too high, expected between 0.5/s and 1/s`,
+			))
+		})
+	})
+
+	When("the count is zero", func() {
+		It("panics if the rate has a maximum but no minimum", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_RATE", "0/s")
+
+				builder.
+					WithMaximumPerSecond(1).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_RATE (0/s) is invalid: count must be at least 1`,
+			))
+		})
+
+		It("panics if the default value has a count of zero", func() {
+			Expect(func() {
+				builder.
+					WithDefault(0, time.Second).
+					Required()
+			}).To(PanicWith("specification for FERRITE_RATE is invalid: default value: count must be at least 1"))
+		})
+
+		It("returns the value if the minimum is zero", func() {
+			os.Setenv("FERRITE_RATE", "0/s")
+
+			v := builder.
+				WithMinimumPerSecond(0).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(RateValue{0, time.Second}))
+		})
+	})
+
+	When("the value is within the limits", func() {
+		It("returns the value", func() {
+			os.Setenv("FERRITE_RATE", "60/m")
+
+			v := builder.
+				WithMinimumPerSecond(1).
+				WithMaximumPerSecond(1).
+				Required().
+				Value()
+
+			Expect(v).To(Equal(RateValue{60, time.Minute}))
+		})
+	})
+})
+
+func ExampleRate_required() {
+	defer example()()
+
+	v := ferrite.
+		Rate("FERRITE_RATE", "example rate variable").
+		Required()
+
+	os.Setenv("FERRITE_RATE", "5000/1m")
+	ferrite.Init()
+
+	r := v.Value()
+	fmt.Println("value is", r)
+	fmt.Println("count is", r.Count, "per", r.Window)
+
+	// Output:
+	// value is 5000/m
+	// count is 5000 per 1m0s
+}
+
+func ExampleRate_default() {
+	defer example()()
+
+	v := ferrite.
+		Rate("FERRITE_RATE", "example rate variable").
+		WithDefault(10, time.Hour).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 10/h
+}
+
+func ExampleRate_optional() {
+	defer example()()
+
+	v := ferrite.
+		Rate("FERRITE_RATE", "example rate variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleRate_limits() {
+	defer example()()
+
+	v := ferrite.
+		Rate("FERRITE_RATE", "example rate variable").
+		WithMinimumPerSecond(1).
+		WithMaximumPerSecond(1000).
+		Required()
+
+	os.Setenv("FERRITE_RATE", "10 per second")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 10/s
+}
+
+func ExampleRate_validation() {
+	defer example()()
+
+	os.Setenv("FERRITE_RATE", "10 per hour")
+	ferrite.
+		Rate("FERRITE_RATE", "example rate variable").
+		Required()
+
+	os.Setenv("FERRITE_RATE_INVALID", "61/m")
+	ferrite.
+		Rate("FERRITE_RATE_INVALID", "example invalid rate variable").
+		WithMaximumPerSecond(1).
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_RATE          example rate variable            <string>    ✓ set to '10 per hour', equivalent to 10/h
+	//  ❯ FERRITE_RATE_INVALID  example invalid rate variable    <string>    ✗ set to 61/m, too high, expected 1/s or less
+	//
+	// <process exited with error code 1>
+}
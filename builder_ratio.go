@@ -0,0 +1,245 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Ratio configures an environment variable as a ratio, such as a sampling rate
+// or a rollout percentage.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// Ratios may be specified as a decimal number, such as "0.25", or as a
+// percentage, such as "25%". In either case the value is normalized to a
+// number between 0 and 1.
+func Ratio(name, desc string) *RatioBuilder {
+	b := &RatioBuilder{
+		schema: variable.TypedNumeric[float64]{
+			Marshaler: ratioMarshaler{},
+			NativeMin: maybe.Some(0.0),
+			NativeMax: maybe.Some(1.0),
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	return b
+}
+
+// RatioBuilder builds a specification for a ratio variable.
+type RatioBuilder struct {
+	schema    variable.TypedNumeric[float64]
+	builder   variable.TypedSpecBuilder[float64]
+	completed bool
+}
+
+var _ isBuilderOf[float64, *RatioBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *RatioBuilder) WithDefault(v float64) *RatioBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithMinimum sets the minimum acceptable value of the variable.
+//
+// v must be between 0 and 1.
+func (b *RatioBuilder) WithMinimum(v float64) *RatioBuilder {
+	if !isValidRatio(v) {
+		panic(fmt.Sprintf("minimum ratio (%v) must be between 0 and 1", v))
+	}
+
+	b.schema.NativeMin = maybe.Some(v)
+	return b
+}
+
+// WithMaximum sets the maximum acceptable value of the variable.
+//
+// v must be between 0 and 1.
+func (b *RatioBuilder) WithMaximum(v float64) *RatioBuilder {
+	if !isValidRatio(v) {
+		panic(fmt.Sprintf("maximum ratio (%v) must be between 0 and 1", v))
+	}
+
+	b.schema.NativeMax = maybe.Some(v)
+	return b
+}
+
+// WithCanonicalPercentage displays values of the variable as percentages, such
+// as "25%", instead of decimal numbers, such as "0.25".
+//
+// It does not affect which notations are accepted, nor the value returned by
+// the variable, which is always a number between 0 and 1.
+func (b *RatioBuilder) WithCanonicalPercentage() *RatioBuilder {
+	b.schema.Marshaler = ratioMarshaler{
+		Percentage: true,
+	}
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *RatioBuilder) Required(options ...RequiredOption) Required[float64] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *RatioBuilder) Optional(options ...OptionalOption) Optional[float64] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *RatioBuilder) Deprecated(options ...DeprecatedOption) Deprecated[float64] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *RatioBuilder) element() (variable.TypedSchema[float64], *variable.TypedSpecBuilder[float64]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds documentation and an example that depend on the builder's
+// options.
+//
+// The example is the first of a few "round" ratios that falls within the
+// permitted range.
+//
+// It has no effect if it has already been called.
+func (b *RatioBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	canonical := "decimal numbers"
+	if m, _ := b.schema.Marshaler.(ratioMarshaler); m.Percentage {
+		canonical = "percentages"
+	}
+
+	b.builder.Documentation().
+		Summary("Ratio syntax").
+		Paragraph(
+			"Ratios may be specified as a decimal number between 0 and 1, such as `0.25`,",
+			"or as a percentage between 0%% and 100%%, such as `25%%`.",
+			"Both notations are equivalent; `0.25` and `25%%` represent the same value.",
+			"Values are displayed as %s.",
+		).
+		Format(canonical).
+		Paragraph(
+			"Only plain decimal notation is accepted.",
+			"Signs, scientific notation, `NaN` and `Inf` are not accepted.",
+		).
+		Format().
+		Done()
+
+	lo := b.schema.NativeMin.Get
+	hi := b.schema.NativeMax.Get
+
+	for _, v := range []float64{0.25, 0.5, 0.1, 0.75, 0.05, 0.9, 0.01, 0.99} {
+		if min, ok := lo(); ok && v < min {
+			continue
+		}
+
+		if max, ok := hi(); ok && v > max {
+			continue
+		}
+
+		b.builder.NonNormativeExample(v, "")
+		return
+	}
+}
+
+// isValidRatio returns true if v is a number between 0 and 1, inclusive.
+func isValidRatio(v float64) bool {
+	return v >= 0 && v <= 1
+}
+
+type ratioMarshaler struct {
+	// Percentage indicates whether values are marshaled as percentages rather
+	// than decimal numbers.
+	Percentage bool
+}
+
+func (m ratioMarshaler) Marshal(v float64) (variable.Literal, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return variable.Literal{}, errors.New("expected a finite number")
+	}
+
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+
+	if m.Percentage {
+		// Scale the shortest decimal representation of v rather than v itself,
+		// so that values such as 0.07 are not rendered as 7.000000000000001%.
+		n, _ := new(big.Rat).SetString(s)
+		n.Mul(n, big.NewRat(100, 1))
+
+		s = n.FloatString(len(s))
+		s = strings.TrimRight(s, "0")
+		s = strings.TrimSuffix(s, ".")
+		s += "%"
+	}
+
+	return variable.Literal{
+		String: s,
+	}, nil
+}
+
+func (m ratioMarshaler) Unmarshal(v variable.Literal) (float64, error) {
+	number := v.String
+	isPercentage := strings.HasSuffix(number, "%")
+	if isPercentage {
+		number = strings.TrimSpace(number[:len(number)-1])
+	}
+
+	if !isPlainDecimal(number) {
+		return 0, errors.New("expected a ratio such as 0.25 or a percentage such as 25%")
+	}
+
+	n, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return 0, errors.New("expected a ratio such as 0.25 or a percentage such as 25%")
+	}
+
+	if isPercentage {
+		n.Quo(n, big.NewRat(100, 1))
+	}
+
+	f, _ := n.Float64()
+	return f, nil
+}
+
+// isPlainDecimal returns true if s consists only of decimal digits and at most
+// one decimal point, with at least one digit.
+func isPlainDecimal(s string) bool {
+	digits := 0
+	points := 0
+
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '.':
+			points++
+		default:
+			return false
+		}
+	}
+
+	return digits > 0 && points <= 1
+}
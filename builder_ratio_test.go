@@ -0,0 +1,306 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type RatioBuilder", func() {
+	var builder *RatioBuilder
+
+	BeforeEach(func() {
+		builder = Ratio("FERRITE_RATIO", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Ratio("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Ratio("FERRITE_RATIO", "").Optional()
+		}).To(PanicWith("specification for FERRITE_RATIO is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the minimum is not between 0 and 1", func() {
+		Expect(func() {
+			builder.WithMinimum(-0.5)
+		}).To(PanicWith("minimum ratio (-0.5) must be between 0 and 1"))
+	})
+
+	It("panics if the maximum is not between 0 and 1", func() {
+		Expect(func() {
+			builder.WithMaximum(1.5)
+		}).To(PanicWith("maximum ratio (1.5) must be between 0 and 1"))
+	})
+
+	It("panics if the default value is out of range", func() {
+		Expect(func() {
+			builder.
+				WithDefault(2).
+				Required()
+		}).To(PanicWith("specification for FERRITE_RATIO is invalid: default value: too high, expected between 0 and 1"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid ratio", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect float64) {
+						os.Setenv("FERRITE_RATIO", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("decimal", "0.25", 0.25),
+					Entry("decimal without leading zero", ".5", 0.5),
+					Entry("zero", "0", 0.0),
+					Entry("one", "1", 1.0),
+					Entry("percentage", "25%", 0.25),
+					Entry("fractional percentage", "12.5%", 0.125),
+					Entry("percentage that is not exactly representable", "7%", 0.07),
+					Entry("whitespace before percent sign", "50 %", 0.5),
+					Entry("one hundred percent", "100%", 1.0),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_RATIO", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"not a number",
+						"half",
+						`value of FERRITE_RATIO (half) is invalid: expected a ratio such as 0.25 or a percentage such as 25%`,
+					),
+					Entry(
+						"missing number",
+						"%",
+						`value of FERRITE_RATIO (%) is invalid: expected a ratio such as 0.25 or a percentage such as 25%`,
+					),
+					Entry(
+						"scientific notation",
+						"2.5e-1",
+						`value of FERRITE_RATIO (2.5e-1) is invalid: expected a ratio such as 0.25 or a percentage such as 25%`,
+					),
+					Entry(
+						"negative",
+						"-0.5",
+						`value of FERRITE_RATIO (-0.5) is invalid: expected a ratio such as 0.25 or a percentage such as 25%`,
+					),
+					Entry(
+						"decimal greater than one",
+						"1.5",
+						`value of FERRITE_RATIO (1.5) is invalid: too high, expected between 0 and 1`,
+					),
+					Entry(
+						"percentage greater than one hundred",
+						"150%",
+						`value of FERRITE_RATIO (150%) is invalid: too high, expected between 0 and 1`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(0.1).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(0.1))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_RATIO is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the value is lower than the minimum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_RATIO", "5%")
+
+				builder.
+					WithMinimum(0.1).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_RATIO (5%) is invalid: too low, expected between 0.1 and 1`,
+			))
+		})
+	})
+
+	When("the value is greater than the maximum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_RATIO", "0.75")
+
+				builder.
+					WithMaximum(0.5).
+					WithCanonicalPercentage().
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_RATIO (0.75) is invalid: too high, expected between 0% and 50%`,
+			))
+		})
+	})
+})
+
+func ExampleRatio_required() {
+	defer example()()
+
+	v := ferrite.
+		Ratio("FERRITE_RATIO", "example ratio variable").
+		Required()
+
+	os.Setenv("FERRITE_RATIO", "25%")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 0.25
+}
+
+func ExampleRatio_default() {
+	defer example()()
+
+	v := ferrite.
+		Ratio("FERRITE_RATIO", "example ratio variable").
+		WithDefault(0.5).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 0.5
+}
+
+func ExampleRatio_optional() {
+	defer example()()
+
+	v := ferrite.
+		Ratio("FERRITE_RATIO", "example ratio variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleRatio_limits() {
+	defer example()()
+
+	v := ferrite.
+		Ratio("FERRITE_RATIO", "example ratio variable").
+		WithMinimum(0.01).
+		WithMaximum(0.5).
+		Required()
+
+	os.Setenv("FERRITE_RATIO", "12.5%")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 0.125
+}
+
+func ExampleRatio_validation() {
+	defer example()()
+
+	os.Setenv("FERRITE_RATIO", "25%")
+	ferrite.
+		Ratio("FERRITE_RATIO", "example ratio variable").
+		Required()
+
+	os.Setenv("FERRITE_RATIO_PERCENTAGE", "0.075")
+	ferrite.
+		Ratio("FERRITE_RATIO_PERCENTAGE", "example percentage variable").
+		WithCanonicalPercentage().
+		Required()
+
+	os.Setenv("FERRITE_RATIO_INVALID", "150%")
+	ferrite.
+		Ratio("FERRITE_RATIO_INVALID", "example invalid ratio variable").
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_RATIO             example ratio variable            0 .. 1        ✓ set to 25%, equivalent to 0.25
+	//  ❯ FERRITE_RATIO_INVALID     example invalid ratio variable    0 .. 1        ✗ set to 150%, too high, expected between 0 and 1
+	//    FERRITE_RATIO_PERCENTAGE  example percentage variable       0% .. 100%    ✓ set to 0.075, equivalent to 7.5%
+	//
+	// <process exited with error code 1>
+}
@@ -0,0 +1,229 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Regexp configures an environment variable as a regular expression.
+//
+// The value is compiled when the environment is validated, such that an
+// invalid expression is reported at startup instead of when it is first used.
+// The expressions use the RE2 syntax accepted by the standard regexp package.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Regexp(name, desc string) *RegexpBuilder {
+	b := &RegexpBuilder{
+		schema: variable.TypedOther[*regexp.Regexp]{
+			Marshaler: regexpMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.NonNormativeExample(
+		regexp.MustCompile(`^[a-z][a-z0-9-]*$`),
+		"matches lowercase identifiers, such as `my-service`",
+	)
+
+	return b
+}
+
+// RegexpBuilder builds a specification for a regular expression variable.
+type RegexpBuilder struct {
+	schema    variable.TypedOther[*regexp.Regexp]
+	builder   variable.TypedSpecBuilder[*regexp.Regexp]
+	anchored  bool
+	completed bool
+}
+
+var _ isBuilderOf[*regexp.Regexp, *RegexpBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *RegexpBuilder) WithDefault(v string) *RegexpBuilder {
+	b.builder.Default(regexp.MustCompile(v))
+	return b
+}
+
+// WithFullAnchor requires the expression to be anchored to both the start
+// and end of the input, using ^ and $ (or \A and \z).
+//
+// This ensures that the expression matches entire strings, and not just a
+// substring of its input.
+func (b *RegexpBuilder) WithFullAnchor() *RegexpBuilder {
+	b.anchored = true
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *RegexpBuilder) Required(options ...RequiredOption) Required[*regexp.Regexp] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *RegexpBuilder) Optional(options ...OptionalOption) Optional[*regexp.Regexp] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *RegexpBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*regexp.Regexp] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *RegexpBuilder) element() (variable.TypedSchema[*regexp.Regexp], *variable.TypedSpecBuilder[*regexp.Regexp]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints and documentation that depend on the builder's
+// options.
+//
+// It has no effect if it has already been called.
+func (b *RegexpBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	doc := b.builder.Documentation().
+		Summary("Regular expression syntax").
+		Paragraph(
+			"Regular expressions use the RE2 syntax,",
+			"which is similar to the syntax used by Perl and PCRE,",
+			"except that backreferences and lookaround assertions are not supported.",
+		).
+		Format()
+
+	if !b.anchored {
+		b.schema.Requirement = "**MUST** be a valid regular expression"
+		doc.Done()
+		return
+	}
+
+	b.builder.BuiltInConstraint(
+		"**MUST** be a valid regular expression that is anchored to both the start and end of the input",
+		func(v *regexp.Regexp) variable.ConstraintError {
+			re, err := syntax.Parse(v.String(), syntax.Perl)
+			if err != nil {
+				return err
+			}
+
+			if !isAnchoredAtStart(re) {
+				return errors.New("expression must begin with ^ or \\A")
+			}
+
+			if !isAnchoredAtEnd(re) {
+				return errors.New("expression must end with $ or \\z")
+			}
+
+			return nil
+		},
+	)
+
+	doc.
+		Paragraph(
+			"The expression must begin with `^` and end with `$`,",
+			"such that it matches the entire input rather than a substring of it.",
+		).
+		Format().
+		Done()
+}
+
+// isAnchoredAtStart returns true if every match of re must begin at the start
+// of the input.
+func isAnchoredAtStart(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpBeginText:
+		return true
+	case syntax.OpCapture:
+		return isAnchoredAtStart(re.Sub[0])
+	case syntax.OpConcat:
+		return len(re.Sub) != 0 && isAnchoredAtStart(re.Sub[0])
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if !isAnchoredAtStart(sub) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// isAnchoredAtEnd returns true if every match of re must end at the end of the
+// input.
+func isAnchoredAtEnd(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpEndText:
+		return true
+	case syntax.OpCapture:
+		return isAnchoredAtEnd(re.Sub[0])
+	case syntax.OpConcat:
+		return len(re.Sub) != 0 && isAnchoredAtEnd(re.Sub[len(re.Sub)-1])
+	case syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if !isAnchoredAtEnd(sub) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+type regexpMarshaler struct{}
+
+func (regexpMarshaler) Marshal(v *regexp.Regexp) (variable.Literal, error) {
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (regexpMarshaler) Unmarshal(v variable.Literal) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(v.String)
+	if err == nil {
+		return re, nil
+	}
+
+	var syntaxErr *syntax.Error
+	if !errors.As(err, &syntaxErr) {
+		return nil, err
+	}
+
+	// The error only includes the offending fragment of the expression, so the
+	// offset is only reported if the fragment occurs exactly once, and is not
+	// the entire expression.
+	if syntaxErr.Expr != "" &&
+		syntaxErr.Expr != v.String &&
+		strings.Count(v.String, syntaxErr.Expr) == 1 {
+		offset := strings.Index(v.String, syntaxErr.Expr)
+		return nil, fmt.Errorf(
+			"syntax error at offset %d: %s: %s",
+			offset,
+			syntaxErr.Code,
+			syntaxErr.Expr,
+		)
+	}
+
+	return nil, fmt.Errorf(
+		"syntax error: %s: %s",
+		syntaxErr.Code,
+		syntaxErr.Expr,
+	)
+}
@@ -0,0 +1,294 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type RegexpBuilder", func() {
+	var builder *RegexpBuilder
+
+	BeforeEach(func() {
+		builder = Regexp("FERRITE_REGEXP", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Regexp("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Regexp("FERRITE_REGEXP", "").Optional()
+		}).To(PanicWith("specification for FERRITE_REGEXP is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value is not anchored when anchors are required", func() {
+		Expect(func() {
+			builder.
+				WithFullAnchor().
+				WithDefault(`^abc`).
+				Optional()
+		}).To(PanicWith(`specification for FERRITE_REGEXP is invalid: default value: expression must end with $ or \z`))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid expression", func() {
+			Describe("func Value()", func() {
+				It("returns the compiled expression", func() {
+					os.Setenv("FERRITE_REGEXP", `^item-\d+$`)
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v.String()).To(Equal(`^item-\d+$`))
+					Expect(v.MatchString("item-123")).To(BeTrue())
+					Expect(v.MatchString("item-abc")).To(BeFalse())
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_REGEXP", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing closing parenthesis",
+						`(abc`,
+						`value of FERRITE_REGEXP ('(abc') is invalid: syntax error: missing closing ): (abc`,
+					),
+					Entry(
+						"invalid repetition",
+						`abc**`,
+						`value of FERRITE_REGEXP ('abc**') is invalid: syntax error at offset 3: invalid nested repetition operator: **`,
+					),
+					Entry(
+						"invalid escape sequence",
+						`abc\yz`,
+						`value of FERRITE_REGEXP ('abc\yz') is invalid: syntax error at offset 3: invalid escape sequence: \y`,
+					),
+					Entry(
+						"repeated invalid fragment",
+						`a\yb\y`,
+						`value of FERRITE_REGEXP ('a\yb\y') is invalid: syntax error: invalid escape sequence: \y`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(`^[a-z]+$`).
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal(`^[a-z]+$`))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_REGEXP is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the expression must be fully anchored", func() {
+		DescribeTable(
+			"it accepts anchored expressions",
+			func(value string) {
+				os.Setenv("FERRITE_REGEXP", value)
+
+				v := builder.
+					WithFullAnchor().
+					Required().
+					Value()
+
+				Expect(v.String()).To(Equal(value))
+			},
+			Entry("caret and dollar", `^abc$`),
+			Entry("text anchors", `\Aabc\z`),
+			Entry("capture group", `(^abc$)`),
+			Entry("alternation", `^abc$|^def$`),
+		)
+
+		DescribeTable(
+			"it panics if the expression is not anchored",
+			func(value, expect string) {
+				os.Setenv("FERRITE_REGEXP", value)
+
+				Expect(func() {
+					builder.
+						WithFullAnchor().
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"no anchors",
+				`abc`,
+				`value of FERRITE_REGEXP (abc) is invalid: expression must begin with ^ or \A`,
+			),
+			Entry(
+				"missing end anchor",
+				`^abc`,
+				`value of FERRITE_REGEXP ('^abc') is invalid: expression must end with $ or \z`,
+			),
+			Entry(
+				"unanchored alternative",
+				`^abc$|def`,
+				`value of FERRITE_REGEXP ('^abc$|def') is invalid: expression must begin with ^ or \A`,
+			),
+			Entry(
+				"multi-line mode",
+				`(?m)^abc$`,
+				`value of FERRITE_REGEXP ('(?m)^abc$') is invalid: expression must begin with ^ or \A`,
+			),
+		)
+	})
+})
+
+func ExampleRegexp_required() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Required()
+
+	os.Setenv("FERRITE_REGEXP", `^item-\d+$`)
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+	fmt.Println("matches item-123?", v.Value().MatchString("item-123"))
+
+	// Output:
+	// value is ^item-\d+$
+	// matches item-123? true
+}
+
+func ExampleRegexp_default() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		WithDefault(`^item-\d+$`).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is ^item-\d+$
+}
+
+func ExampleRegexp_optional() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleRegexp_anchored() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		WithFullAnchor().
+		Required()
+
+	os.Setenv("FERRITE_REGEXP", `^item-\d+$`)
+	ferrite.Init()
+
+	fmt.Println("matches item-123?", v.Value().MatchString("item-123"))
+	fmt.Println("matches my-item-123?", v.Value().MatchString("my-item-123"))
+
+	// Output:
+	// matches item-123? true
+	// matches my-item-123? false
+}
+
+func ExampleRegexp_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_REGEXP", `^item-\d+$`)
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_REGEXP  example regular expression variable  [ <string> ]  ⚠ deprecated variable set to '^item-\d+$'
+	//
+	// value is ^item-\d+$
+}
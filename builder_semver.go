@@ -0,0 +1,340 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// SemVer configures an environment variable as a semantic version.
+//
+// Versions must conform to Semantic Versioning 2.0, such as "1.4.0" or
+// "2.0.0-rc.1+build.5". A leading "v" is permitted, but it is not part of the
+// canonical representation of the value.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func SemVer(name, desc string) *SemVerBuilder {
+	b := &SemVerBuilder{
+		schema: variable.TypedOther[SemVerValue]{
+			Marshaler:   semVerMarshaler{},
+			Requirement: "**MUST** be a valid semantic version",
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.NonNormativeExample(mustParseSemVer("1.4.0"), "a release version")
+	b.builder.NonNormativeExample(mustParseSemVer("2.0.0-rc.1"), "a pre-release version")
+	b.builder.Documentation().
+		Summary("Semantic version syntax").
+		Paragraph(
+			"Versions must conform to the Semantic Versioning 2.0 specification.",
+			"A version consists of the major, minor and patch version numbers separated by dots,",
+			"optionally followed by a hyphen and a dot-separated list of pre-release identifiers,",
+			"then by a plus sign and a dot-separated list of build metadata identifiers.",
+		).
+		Format().
+		Paragraph(
+			"Version numbers must not have leading zeros.",
+			"A leading `v` is permitted, but it is not part of the canonical representation of the version.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// SemVerBuilder builds a specification for a semantic version variable.
+type SemVerBuilder struct {
+	schema  variable.TypedOther[SemVerValue]
+	builder variable.TypedSpecBuilder[SemVerValue]
+}
+
+var _ isBuilderOf[SemVerValue, *SemVerBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. It panics if
+// v is not a valid semantic version.
+func (b *SemVerBuilder) WithDefault(v string) *SemVerBuilder {
+	b.builder.Default(mustParseSemVer(v))
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the environment variable value after it is parsed. If fn
+// returns false the value is considered invalid.
+func (b *SemVerBuilder) WithConstraint(
+	desc string,
+	fn func(SemVerValue) bool,
+) *SemVerBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *SemVerBuilder) Required(options ...RequiredOption) Required[SemVerValue] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *SemVerBuilder) Optional(options ...OptionalOption) Optional[SemVerValue] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *SemVerBuilder) Deprecated(options ...DeprecatedOption) Deprecated[SemVerValue] {
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *SemVerBuilder) element() (variable.TypedSchema[SemVerValue], *variable.TypedSpecBuilder[SemVerValue]) {
+	return b.schema, &b.builder
+}
+
+// SemVerValue is a semantic version, as per Semantic Versioning 2.0.
+type SemVerValue struct {
+	Major, Minor, Patch uint64
+
+	// Prerelease is the dot-separated list of pre-release identifiers, without
+	// the leading hyphen. It is empty for release versions.
+	Prerelease string
+
+	// Build is the dot-separated list of build metadata identifiers, without
+	// the leading plus sign.
+	Build string
+}
+
+// String returns the canonical representation of the version.
+func (v SemVerValue) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+
+	return s
+}
+
+// IsPrerelease returns true if v is a pre-release version.
+func (v SemVerValue) IsPrerelease() bool {
+	return v.Prerelease != ""
+}
+
+// Compare returns -1 if v has a lower precedence than x, 1 if v has a higher
+// precedence than x, or 0 if they have the same precedence.
+//
+// Build metadata is not considered when determining precedence.
+func (v SemVerValue) Compare(x SemVerValue) int {
+	if c := compareUint(v.Major, x.Major); c != 0 {
+		return c
+	}
+
+	if c := compareUint(v.Minor, x.Minor); c != 0 {
+		return c
+	}
+
+	if c := compareUint(v.Patch, x.Patch); c != 0 {
+		return c
+	}
+
+	return comparePrerelease(v.Prerelease, x.Prerelease)
+}
+
+// LessThan returns true if v has a lower precedence than x.
+func (v SemVerValue) LessThan(x SemVerValue) bool {
+	return v.Compare(x) < 0
+}
+
+// GreaterThan returns true if v has a higher precedence than x.
+func (v SemVerValue) GreaterThan(x SemVerValue) bool {
+	return v.Compare(x) > 0
+}
+
+// Satisfies returns true if v satisfies the constraint c.
+func (v SemVerValue) Satisfies(c SemVerConstraintValue) bool {
+	return c.Allows(v)
+}
+
+// compareUint returns -1, 0 or 1 depending on whether a is less than, equal to
+// or greater than b, respectively.
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares two lists of pre-release identifiers according to
+// the precedence rules of Semantic Versioning 2.0.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1 // a release has a higher precedence than any pre-release
+	case b == "":
+		return -1
+	}
+
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.ParseUint(as[i], 10, 64)
+		bn, bErr := strconv.ParseUint(bs[i], 10, 64)
+
+		switch {
+		case aErr == nil && bErr == nil:
+			if c := compareUint(an, bn); c != 0 {
+				return c
+			}
+		case aErr == nil:
+			return -1 // numeric identifiers have a lower precedence
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return compareUint(uint64(len(as)), uint64(len(bs)))
+}
+
+// parseSemVer parses a semantic version.
+func parseSemVer(s string) (SemVerValue, error) {
+	var v SemVerValue
+
+	s = strings.TrimPrefix(s, "v")
+
+	s, build, hasBuild := strings.Cut(s, "+")
+	if hasBuild {
+		if err := validateSemVerIdentifiers("build metadata", build, false); err != nil {
+			return SemVerValue{}, err
+		}
+		v.Build = build
+	}
+
+	s, pre, hasPre := strings.Cut(s, "-")
+	if hasPre {
+		if err := validateSemVerIdentifiers("pre-release", pre, true); err != nil {
+			return SemVerValue{}, err
+		}
+		v.Prerelease = pre
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return SemVerValue{}, fmt.Errorf(
+			"expected 3 dot-separated version numbers (major.minor.patch), got %d",
+			len(parts),
+		)
+	}
+
+	for i, p := range []*uint64{&v.Major, &v.Minor, &v.Patch} {
+		n, err := parseSemVerNumber(semVerComponents[i], parts[i])
+		if err != nil {
+			return SemVerValue{}, err
+		}
+		*p = n
+	}
+
+	return v, nil
+}
+
+// mustParseSemVer parses a semantic version, or panics if it is invalid.
+func mustParseSemVer(s string) SemVerValue {
+	v, err := parseSemVer(s)
+	if err != nil {
+		panic(fmt.Sprintf("invalid semantic version (%s): %s", s, err))
+	}
+	return v
+}
+
+// semVerComponents is the names of the numeric components of a version.
+var semVerComponents = []string{"major", "minor", "patch"}
+
+// parseSemVerNumber parses a single numeric component of a version.
+func parseSemVerNumber(component, s string) (uint64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("%s version must not be empty", component)
+	}
+
+	n, err := strconv.ParseUint(s, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("%s version (%s) is too large", component, s)
+	} else if err != nil {
+		return 0, fmt.Errorf("%s version (%s) must be a non-negative integer", component, s)
+	}
+
+	if len(s) > 1 && s[0] == '0' {
+		return 0, fmt.Errorf("%s version (%s) must not have leading zeros", component, s)
+	}
+
+	return n, nil
+}
+
+// validateSemVerIdentifiers returns an error if s is not a valid list of
+// pre-release or build metadata identifiers.
+func validateSemVerIdentifiers(kind, s string, checkLeadingZeros bool) error {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return fmt.Errorf("%s identifiers must not be empty", kind)
+		}
+
+		numeric := true
+		for i := 0; i < len(id); i++ {
+			ch := id[i]
+
+			switch {
+			case ch >= '0' && ch <= '9':
+			case ch >= 'A' && ch <= 'Z', ch >= 'a' && ch <= 'z', ch == '-':
+				numeric = false
+			default:
+				return fmt.Errorf(
+					"%s identifier (%s) must contain only ASCII letters, digits and hyphens",
+					kind,
+					id,
+				)
+			}
+		}
+
+		if checkLeadingZeros && numeric && len(id) > 1 && id[0] == '0' {
+			return fmt.Errorf(
+				"numeric %s identifier (%s) must not have leading zeros",
+				kind,
+				id,
+			)
+		}
+	}
+
+	return nil
+}
+
+type semVerMarshaler struct{}
+
+func (semVerMarshaler) Marshal(v SemVerValue) (variable.Literal, error) {
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (semVerMarshaler) Unmarshal(v variable.Literal) (SemVerValue, error) {
+	return parseSemVer(v.String)
+}
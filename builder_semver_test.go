@@ -0,0 +1,297 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type SemVerBuilder", func() {
+	var builder *SemVerBuilder
+
+	BeforeEach(func() {
+		builder = SemVer("FERRITE_SEMVER", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			SemVer("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			SemVer("FERRITE_SEMVER", "").Optional()
+		}).To(PanicWith("specification for FERRITE_SEMVER is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		Expect(func() {
+			builder.WithDefault("1.4")
+		}).To(PanicWith("invalid semantic version (1.4): expected 3 dot-separated version numbers (major.minor.patch), got 2"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid version", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the version",
+					func(value string, expect SemVerValue) {
+						os.Setenv("FERRITE_SEMVER", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("release", "1.4.0", SemVerValue{Major: 1, Minor: 4}),
+					Entry("leading v", "v1.4.0", SemVerValue{Major: 1, Minor: 4}),
+					Entry("pre-release", "2.0.0-rc.1", SemVerValue{Major: 2, Prerelease: "rc.1"}),
+					Entry("build metadata", "2.0.0+build.5", SemVerValue{Major: 2, Build: "build.5"}),
+					Entry("pre-release and build metadata", "2.0.0-rc.1+build.5", SemVerValue{Major: 2, Prerelease: "rc.1", Build: "build.5"}),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_SEMVER", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing patch version",
+						"1.4",
+						`value of FERRITE_SEMVER (1.4) is invalid: expected 3 dot-separated version numbers (major.minor.patch), got 2`,
+					),
+					Entry(
+						"leading zeros",
+						"1.04.0",
+						`value of FERRITE_SEMVER (1.04.0) is invalid: minor version (04) must not have leading zeros`,
+					),
+					Entry(
+						"non-numeric version",
+						"1.4.x",
+						`value of FERRITE_SEMVER (1.4.x) is invalid: patch version (x) must be a non-negative integer`,
+					),
+					Entry(
+						"empty pre-release identifier",
+						"1.4.0-rc..1",
+						`value of FERRITE_SEMVER (1.4.0-rc..1) is invalid: pre-release identifiers must not be empty`,
+					),
+					Entry(
+						"numeric pre-release identifier with leading zeros",
+						"1.4.0-rc.01",
+						`value of FERRITE_SEMVER (1.4.0-rc.01) is invalid: numeric pre-release identifier (01) must not have leading zeros`,
+					),
+					Entry(
+						"invalid build metadata",
+						"1.4.0+build_5",
+						`value of FERRITE_SEMVER (1.4.0+build_5) is invalid: build metadata identifier (build_5) must contain only ASCII letters, digits and hyphens`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("v1.4.0").
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("1.4.0"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_SEMVER is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("type SemVerValue", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	version := func(v string) SemVerValue {
+		os.Setenv("FERRITE_SEMVER", v)
+		defer tearDown()
+
+		return SemVer("FERRITE_SEMVER", "<desc>").
+			Required().
+			Value()
+	}
+
+	Describe("func Compare()", func() {
+		It("orders versions by precedence", func() {
+			versions := []string{
+				"1.0.0-alpha",
+				"1.0.0-alpha.1",
+				"1.0.0-alpha.beta",
+				"1.0.0-beta",
+				"1.0.0-beta.2",
+				"1.0.0-beta.11",
+				"1.0.0-rc.1",
+				"1.0.0",
+				"1.0.1",
+				"1.1.0",
+				"2.0.0",
+			}
+
+			for i := 1; i < len(versions); i++ {
+				a := version(versions[i-1])
+				b := version(versions[i])
+
+				Expect(a.Compare(b)).To(Equal(-1), "%s < %s", a, b)
+				Expect(b.Compare(a)).To(Equal(1), "%s > %s", b, a)
+				Expect(a.LessThan(b)).To(BeTrue())
+				Expect(b.GreaterThan(a)).To(BeTrue())
+			}
+		})
+
+		It("ignores build metadata", func() {
+			Expect(version("1.0.0+a").Compare(version("1.0.0+b"))).To(Equal(0))
+		})
+	})
+})
+
+func ExampleSemVer_required() {
+	defer example()()
+
+	v := ferrite.
+		SemVer("FERRITE_SEMVER", "example semantic version variable").
+		Required()
+
+	os.Setenv("FERRITE_SEMVER", "1.4.0")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 1.4.0
+}
+
+func ExampleSemVer_default() {
+	defer example()()
+
+	v := ferrite.
+		SemVer("FERRITE_SEMVER", "example semantic version variable").
+		WithDefault("1.4.0").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 1.4.0
+}
+
+func ExampleSemVer_optional() {
+	defer example()()
+
+	v := ferrite.
+		SemVer("FERRITE_SEMVER", "example semantic version variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleSemVer_invalid() {
+	defer example()()
+
+	ferrite.
+		SemVer("FERRITE_SEMVER", "example semantic version variable").
+		Required()
+
+	os.Setenv("FERRITE_SEMVER", "1.04")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_SEMVER  example semantic version variable    <string>    ✗ set to 1.04, expected 3 dot-separated version numbers (major.minor.patch), got 2
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleSemVer_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_SEMVER", "v1.4.0")
+	v := ferrite.
+		SemVer("FERRITE_SEMVER", "example semantic version variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_SEMVER  example semantic version variable  [ <string> ]  ⚠ deprecated variable set to v1.4.0, equivalent to 1.4.0
+	//
+	// value is 1.4.0
+}
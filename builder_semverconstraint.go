@@ -0,0 +1,392 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// SemVerConstraint configures an environment variable as a constraint on
+// semantic versions, such as ">=2.0 <3.0" or "^1.4".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func SemVerConstraint(name, desc string) *SemVerConstraintBuilder {
+	b := &SemVerConstraintBuilder{
+		schema: variable.TypedOther[SemVerConstraintValue]{
+			Marshaler:   semVerConstraintMarshaler{},
+			Requirement: "**MUST** be a valid semantic version constraint",
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.NonNormativeExample(mustParseSemVerConstraint(">=2.0.0 <3.0.0"), "any 2.x version")
+	b.builder.NonNormativeExample(mustParseSemVerConstraint("^1.4"), "1.4.0 or any later 1.x version")
+	b.builder.NonNormativeExample(mustParseSemVerConstraint("~1.4.2 || ~1.5.1"), "a patch release of either 1.4 or 1.5")
+	b.builder.Documentation().
+		Summary("Version constraint syntax").
+		Paragraph(
+			"A constraint consists of one or more comparators separated by spaces or commas,",
+			"all of which must be satisfied by a version.",
+			"Alternative sets of comparators may be separated by `||`,",
+			"in which case a version must satisfy any one of the sets.",
+		).
+		Format().
+		Paragraph(
+			"Each comparator is a semantic version, optionally preceded by an operator.",
+			"The `=`, `!=`, `>`, `>=`, `<` and `<=` operators compare versions by precedence,",
+			"with missing minor and patch version numbers treated as zero.",
+			"A version with no operator, or with the `=` operator,",
+			"matches any version that begins with the version numbers that are specified,",
+			"which may also be written using `x` or `*` as a wildcard, such as `1.4.x`.",
+		).
+		Format().
+		Paragraph(
+			"The `~` operator permits patch-level changes if a minor version is specified,",
+			"such that `~1.4.2` is equivalent to `>=1.4.2 <1.5.0`.",
+			"The `^` operator permits changes that do not modify the left-most non-zero version number,",
+			"such that `^1.4.2` is equivalent to `>=1.4.2 <2.0.0`.",
+		).
+		Format().
+		Paragraph(
+			"Pre-release versions only satisfy a set of comparators if one of the comparators",
+			"refers to a pre-release of the same major, minor and patch version.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// SemVerConstraintBuilder builds a specification for a semantic version
+// constraint variable.
+type SemVerConstraintBuilder struct {
+	schema  variable.TypedOther[SemVerConstraintValue]
+	builder variable.TypedSpecBuilder[SemVerConstraintValue]
+}
+
+var _ isBuilderOf[SemVerConstraintValue, *SemVerConstraintBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. It panics if
+// v is not a valid version constraint.
+func (b *SemVerConstraintBuilder) WithDefault(v string) *SemVerConstraintBuilder {
+	b.builder.Default(mustParseSemVerConstraint(v))
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *SemVerConstraintBuilder) Required(options ...RequiredOption) Required[SemVerConstraintValue] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *SemVerConstraintBuilder) Optional(options ...OptionalOption) Optional[SemVerConstraintValue] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *SemVerConstraintBuilder) Deprecated(options ...DeprecatedOption) Deprecated[SemVerConstraintValue] {
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *SemVerConstraintBuilder) element() (variable.TypedSchema[SemVerConstraintValue], *variable.TypedSpecBuilder[SemVerConstraintValue]) {
+	return b.schema, &b.builder
+}
+
+// SemVerConstraintValue is a constraint on semantic versions.
+type SemVerConstraintValue struct {
+	// sets is a list of alternative comparator sets. A version satisfies the
+	// constraint if it satisfies all of the comparators in any one set.
+	sets [][]semVerComparator
+}
+
+// Allows returns true if v satisfies the constraint.
+func (c SemVerConstraintValue) Allows(v SemVerValue) bool {
+	for _, set := range c.sets {
+		if semVerSetAllows(set, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the canonical representation of the constraint.
+func (c SemVerConstraintValue) String() string {
+	var sets []string
+
+	for _, set := range c.sets {
+		var comparators []string
+		for _, cmp := range set {
+			comparators = append(comparators, cmp.String())
+		}
+		sets = append(sets, strings.Join(comparators, " "))
+	}
+
+	return strings.Join(sets, " || ")
+}
+
+// semVerSetAllows returns true if v satisfies all of the comparators in set.
+func semVerSetAllows(set []semVerComparator, v SemVerValue) bool {
+	for _, cmp := range set {
+		if !cmp.allows(v) {
+			return false
+		}
+	}
+
+	if !v.IsPrerelease() {
+		return true
+	}
+
+	// Pre-release versions are only permitted if they are explicitly referred
+	// to by a comparator with the same major, minor and patch version.
+	for _, cmp := range set {
+		if cmp.Version.IsPrerelease() &&
+			cmp.Version.Major == v.Major &&
+			cmp.Version.Minor == v.Minor &&
+			cmp.Version.Patch == v.Patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// semVerComparator is a single comparison within a version constraint.
+type semVerComparator struct {
+	Operator string
+	Version  SemVerValue
+
+	// Parts is the number of version numbers that were specified, from 0 (a
+	// bare wildcard) to 3.
+	Parts int
+}
+
+// String returns the canonical representation of the comparator.
+func (c semVerComparator) String() string {
+	switch c.Operator {
+	case "=":
+		switch c.Parts {
+		case 0:
+			return "*"
+		case 1:
+			return fmt.Sprintf("%d.x", c.Version.Major)
+		case 2:
+			return fmt.Sprintf("%d.%d.x", c.Version.Major, c.Version.Minor)
+		}
+		return c.Version.String()
+
+	case "~", "^":
+		switch c.Parts {
+		case 1:
+			return fmt.Sprintf("%s%d", c.Operator, c.Version.Major)
+		case 2:
+			return fmt.Sprintf("%s%d.%d", c.Operator, c.Version.Major, c.Version.Minor)
+		}
+	}
+
+	return c.Operator + c.Version.String()
+}
+
+// allows returns true if v satisfies the comparison, without considering the
+// special handling of pre-release versions.
+func (c semVerComparator) allows(v SemVerValue) bool {
+	switch c.Operator {
+	case "=":
+		switch c.Parts {
+		case 0:
+			return true
+		case 1:
+			return v.Major == c.Version.Major
+		case 2:
+			return v.Major == c.Version.Major && v.Minor == c.Version.Minor
+		}
+		return v.Compare(c.Version) == 0
+	case "!=":
+		return v.Compare(c.Version) != 0
+	case ">":
+		return v.Compare(c.Version) > 0
+	case ">=":
+		return v.Compare(c.Version) >= 0
+	case "<":
+		return v.Compare(c.Version) < 0
+	case "<=":
+		return v.Compare(c.Version) <= 0
+	case "~":
+		if v.Compare(c.Version) < 0 || v.Major != c.Version.Major {
+			return false
+		}
+		return c.Parts == 1 || v.Minor == c.Version.Minor
+	case "^":
+		if v.Compare(c.Version) < 0 || v.Major != c.Version.Major {
+			return false
+		}
+		if c.Version.Major != 0 || c.Parts == 1 {
+			return true
+		}
+		if v.Minor != c.Version.Minor {
+			return false
+		}
+		return c.Version.Minor != 0 || c.Parts == 2 || v.Patch == c.Version.Patch
+	}
+
+	return false
+}
+
+// semVerOperators is the set of comparison operators, longest first so that
+// they can be matched by prefix.
+var semVerOperators = []string{">=", "<=", "!=", ">", "<", "=", "~", "^"}
+
+// parseSemVerConstraint parses a version constraint.
+func parseSemVerConstraint(s string) (SemVerConstraintValue, error) {
+	var c SemVerConstraintValue
+
+	for _, alt := range strings.Split(s, "||") {
+		var set []semVerComparator
+
+		fields := strings.FieldsFunc(alt, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ','
+		})
+
+		for i := 0; i < len(fields); i++ {
+			f := fields[i]
+
+			// Allow whitespace between an operator and its version, such as
+			// ">= 2.0.0".
+			if isSemVerOperator(f) && i+1 < len(fields) {
+				i++
+				f += fields[i]
+			}
+
+			cmp, err := parseSemVerComparator(f)
+			if err != nil {
+				return SemVerConstraintValue{}, fmt.Errorf("invalid comparator (%s): %w", f, err)
+			}
+
+			set = append(set, cmp)
+		}
+
+		if len(set) == 0 {
+			return SemVerConstraintValue{}, errors.New("alternatives separated by || must not be empty")
+		}
+
+		c.sets = append(c.sets, set)
+	}
+
+	return c, nil
+}
+
+// mustParseSemVerConstraint parses a version constraint, or panics if it is
+// invalid.
+func mustParseSemVerConstraint(s string) SemVerConstraintValue {
+	c, err := parseSemVerConstraint(s)
+	if err != nil {
+		panic(fmt.Sprintf("invalid semantic version constraint (%s): %s", s, err))
+	}
+	return c
+}
+
+// isSemVerOperator returns true if s is a comparison operator.
+func isSemVerOperator(s string) bool {
+	for _, op := range semVerOperators {
+		if s == op {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSemVerComparator parses a single comparator within a version
+// constraint.
+func parseSemVerComparator(s string) (semVerComparator, error) {
+	cmp := semVerComparator{Operator: "="}
+
+	for _, op := range semVerOperators {
+		if strings.HasPrefix(s, op) {
+			cmp.Operator = op
+			s = s[len(op):]
+			break
+		}
+	}
+
+	s = strings.TrimPrefix(s, "v")
+
+	if s == "" {
+		return semVerComparator{}, errors.New("expected a version after the operator")
+	}
+
+	// A version that includes pre-release or build metadata must be complete.
+	if strings.ContainsAny(s, "-+") {
+		v, err := parseSemVer(s)
+		if err != nil {
+			return semVerComparator{}, err
+		}
+
+		cmp.Version = v
+		cmp.Parts = 3
+
+		return cmp, nil
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return semVerComparator{}, fmt.Errorf(
+			"expected at most 3 dot-separated version numbers (major.minor.patch), got %d",
+			len(parts),
+		)
+	}
+
+	numbers := []*uint64{&cmp.Version.Major, &cmp.Version.Minor, &cmp.Version.Patch}
+	wildcard := false
+
+	for i, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			wildcard = true
+			continue
+		}
+
+		if wildcard {
+			return semVerComparator{}, fmt.Errorf(
+				"%s version must not be specified after a wildcard",
+				semVerComponents[i],
+			)
+		}
+
+		n, err := parseSemVerNumber(semVerComponents[i], p)
+		if err != nil {
+			return semVerComparator{}, err
+		}
+
+		*numbers[i] = n
+		cmp.Parts++
+	}
+
+	if cmp.Parts == 0 && cmp.Operator != "=" {
+		return semVerComparator{}, fmt.Errorf(
+			"the %s operator requires at least a major version",
+			cmp.Operator,
+		)
+	}
+
+	return cmp, nil
+}
+
+type semVerConstraintMarshaler struct{}
+
+func (semVerConstraintMarshaler) Marshal(v SemVerConstraintValue) (variable.Literal, error) {
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (semVerConstraintMarshaler) Unmarshal(v variable.Literal) (SemVerConstraintValue, error) {
+	return parseSemVerConstraint(v.String)
+}
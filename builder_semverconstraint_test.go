@@ -0,0 +1,260 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type SemVerConstraintBuilder", func() {
+	var builder *SemVerConstraintBuilder
+
+	BeforeEach(func() {
+		builder = SemVerConstraint("FERRITE_SEMVER_CONSTRAINT", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			SemVerConstraint("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			SemVerConstraint("FERRITE_SEMVER_CONSTRAINT", "").Optional()
+		}).To(PanicWith("specification for FERRITE_SEMVER_CONSTRAINT is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		Expect(func() {
+			builder.WithDefault(">=")
+		}).To(PanicWith("invalid semantic version constraint (>=): invalid comparator (>=): expected a version after the operator"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid constraint", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the canonical constraint",
+					func(value, expect string) {
+						os.Setenv("FERRITE_SEMVER_CONSTRAINT", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal(expect))
+					},
+					Entry("range", ">=2.0 <3.0", ">=2.0.0 <3.0.0"),
+					Entry("comma-separated", ">= 2.0, < 3.0", ">=2.0.0 <3.0.0"),
+					Entry("alternatives", "~1.4.2||~1.5.1", "~1.4.2 || ~1.5.1"),
+					Entry("caret", "^1.4", "^1.4"),
+					Entry("wildcard", "1.4.X", "1.4.x"),
+					Entry("partial version", "=1.4", "1.4.x"),
+					Entry("any version", "*", "*"),
+					Entry("leading v", "v1.4.0", "1.4.0"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_SEMVER_CONSTRAINT", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"invalid version",
+						">=2.01",
+						`value of FERRITE_SEMVER_CONSTRAINT ('>=2.01') is invalid: invalid comparator (>=2.01): minor version (01) must not have leading zeros`,
+					),
+					Entry(
+						"too many version numbers",
+						"1.2.3.4",
+						`value of FERRITE_SEMVER_CONSTRAINT (1.2.3.4) is invalid: invalid comparator (1.2.3.4): expected at most 3 dot-separated version numbers (major.minor.patch), got 4`,
+					),
+					Entry(
+						"version after a wildcard",
+						"1.x.3",
+						`value of FERRITE_SEMVER_CONSTRAINT (1.x.3) is invalid: invalid comparator (1.x.3): patch version must not be specified after a wildcard`,
+					),
+					Entry(
+						"wildcard with an operator",
+						">*",
+						`value of FERRITE_SEMVER_CONSTRAINT ('>*') is invalid: invalid comparator (>*): the > operator requires at least a major version`,
+					),
+					Entry(
+						"empty alternative",
+						"1.x ||",
+						`value of FERRITE_SEMVER_CONSTRAINT ('1.x ||') is invalid: alternatives separated by || must not be empty`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("^1.4").
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("^1.4"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_SEMVER_CONSTRAINT is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("type SemVerConstraintValue", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	Describe("func Allows()", func() {
+		DescribeTable(
+			"it returns true if the version satisfies the constraint",
+			func(constraint, version string, expect bool) {
+				os.Setenv("FERRITE_SEMVER_CONSTRAINT", constraint)
+				os.Setenv("FERRITE_SEMVER", version)
+
+				c := SemVerConstraint("FERRITE_SEMVER_CONSTRAINT", "<desc>").Required()
+				v := SemVer("FERRITE_SEMVER", "<desc>").Required()
+
+				Expect(c.Value().Allows(v.Value())).To(Equal(expect))
+				Expect(v.Value().Satisfies(c.Value())).To(Equal(expect))
+			},
+			Entry("range, lower bound", ">=2.0 <3.0", "2.0.0", true),
+			Entry("range, upper bound", ">=2.0 <3.0", "3.0.0", false),
+			Entry("range, below", ">=2.0 <3.0", "1.9.9", false),
+			Entry("not equal", "!=1.4.0", "1.4.0", false),
+			Entry("greater than", ">1.4.0", "1.4.1", true),
+			Entry("less than or equal", "<=1.4.0", "1.4.0", true),
+			Entry("alternative", "1.x || 3.x", "3.1.0", true),
+			Entry("wildcard", "1.4.x", "1.4.9", true),
+			Entry("wildcard, different minor", "1.4.x", "1.5.0", false),
+			Entry("tilde", "~1.4.2", "1.4.9", true),
+			Entry("tilde, different minor", "~1.4.2", "1.5.0", false),
+			Entry("tilde, major only", "~1", "1.9.0", true),
+			Entry("caret", "^1.4.2", "1.9.0", true),
+			Entry("caret, different major", "^1.4.2", "2.0.0", false),
+			Entry("caret, zero major", "^0.2.3", "0.2.9", true),
+			Entry("caret, zero major, different minor", "^0.2.3", "0.3.0", false),
+			Entry("caret, zero minor", "^0.0.3", "0.0.4", false),
+			Entry("pre-release, not referenced", ">=2.0 <3.0", "2.5.0-rc.1", false),
+			Entry("pre-release, referenced", ">=2.5.0-beta", "2.5.0-rc.1", true),
+			Entry("pre-release, different version", ">=2.5.0-beta", "2.6.0-rc.1", false),
+		)
+	})
+})
+
+func ExampleSemVerConstraint() {
+	defer example()()
+
+	c := ferrite.
+		SemVerConstraint("FERRITE_SEMVER_CONSTRAINT", "example semantic version constraint variable").
+		Required()
+
+	os.Setenv("FERRITE_SEMVER_CONSTRAINT", ">=2.0 <3.0")
+	ferrite.Init()
+
+	v := c.Value()
+	fmt.Println("constraint is", v)
+
+	for _, x := range []ferrite.SemVerValue{
+		{Major: 1, Minor: 9},
+		{Major: 2, Minor: 4, Patch: 1},
+		{Major: 3},
+	} {
+		fmt.Println(x, "allowed:", v.Allows(x))
+	}
+
+	// Output:
+	// constraint is >=2.0.0 <3.0.0
+	// 1.9.0 allowed: false
+	// 2.4.1 allowed: true
+	// 3.0.0 allowed: false
+}
+
+func ExampleSemVerConstraint_default() {
+	defer example()()
+
+	v := ferrite.
+		SemVerConstraint("FERRITE_SEMVER_CONSTRAINT", "example semantic version constraint variable").
+		WithDefault("^1.4").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is ^1.4
+}
+
+func ExampleSemVerConstraint_optional() {
+	defer example()()
+
+	v := ferrite.
+		SemVerConstraint("FERRITE_SEMVER_CONSTRAINT", "example semantic version constraint variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
@@ -32,11 +32,11 @@ func Signed[T constraints.Signed](name, desc string) *SignedBuilder[T] {
 		).
 		Format().
 		Paragraph(
-			"Internally, the `%s` variable is represented using a signed %d-bit integer type (`%s`);",
+			"Internally, %s is represented using a signed %d-bit integer type (`%s`);",
 			"any value that overflows this data-type is invalid.",
 		).
 		Format(
-			name,
+			documentationSubject(name),
 			reflectx.BitSize[T](),
 			reflectx.KindOf[T](),
 		).
@@ -91,6 +91,10 @@ func (b *SignedBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T]
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *SignedBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
+
 type signedMarshaler[T constraints.Signed] struct{}
 
 func (signedMarshaler[T]) Marshal(v T) (variable.Literal, error) {
@@ -80,3 +80,7 @@ func (b *StringBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
 func (b *StringBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
 	return deprecated(b.schema, &b.builder, options...)
 }
+
+func (b *StringBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
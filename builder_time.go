@@ -0,0 +1,387 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dogmatiq/ferrite/maybe"
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Time configures an environment variable as a timestamp.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// By default, timestamps are specified in RFC 3339 format, such as
+// "2006-01-02T15:04:05Z". Use WithLayouts() to accept other formats.
+func Time(name, desc string) *TimeBuilder {
+	b := &TimeBuilder{
+		marshaler: timeMarshaler{
+			Layouts: []TimeLayout{RFC3339Layout},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// TimeLayout is an enumeration of the formats that may be used to specify a
+// timestamp.
+type TimeLayout int
+
+const (
+	// RFC3339Layout is a timestamp in RFC 3339 format, such as
+	// "2006-01-02T15:04:05Z" or "2006-01-02T15:04:05.999+07:00".
+	RFC3339Layout TimeLayout = iota
+
+	// DateLayout is a date in YYYY-MM-DD format, such as "2006-01-02".
+	//
+	// Dates are interpreted as midnight UTC.
+	DateLayout
+
+	// UnixLayout is a Unix timestamp, being a whole number of seconds since
+	// 1970-01-01T00:00:00Z, such as "1136214245".
+	UnixLayout
+)
+
+// TimeBuilder builds a specification for a timestamp variable.
+type TimeBuilder struct {
+	schema     variable.TypedOther[time.Time]
+	builder    variable.TypedSpecBuilder[time.Time]
+	marshaler  timeMarshaler
+	min, max   maybe.Value[time.Time]
+	hasDefault bool
+	completed  bool
+}
+
+var _ isBuilderOf[time.Time, *TimeBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *TimeBuilder) WithDefault(v time.Time) *TimeBuilder {
+	b.builder.Default(v)
+	b.hasDefault = true
+	return b
+}
+
+// WithLayouts sets the formats that may be used to specify the timestamp.
+//
+// layout is the canonical format, which is used when displaying values. Values
+// may also be specified using any of the additional layouts. The default is
+// RFC3339Layout.
+func (b *TimeBuilder) WithLayouts(layout TimeLayout, additional ...TimeLayout) *TimeBuilder {
+	b.marshaler = timeMarshaler{
+		Layouts: append([]TimeLayout{layout}, additional...),
+	}
+	return b
+}
+
+// WithMinimum sets the minimum acceptable value of the variable.
+func (b *TimeBuilder) WithMinimum(v time.Time) *TimeBuilder {
+	b.min = maybe.Some(v)
+	return b
+}
+
+// WithMaximum sets the maximum acceptable value of the variable.
+func (b *TimeBuilder) WithMaximum(v time.Time) *TimeBuilder {
+	b.max = maybe.Some(v)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *TimeBuilder) Required(options ...RequiredOption) Required[time.Time] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *TimeBuilder) Optional(options ...OptionalOption) Optional[time.Time] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *TimeBuilder) Deprecated(options ...DeprecatedOption) Deprecated[time.Time] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *TimeBuilder) element() (variable.TypedSchema[time.Time], *variable.TypedSpecBuilder[time.Time]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints, examples and documentation that depend on the
+// builder's options.
+//
+// It is called when the build process is complete, as the options may be
+// changed at any point before then. It has no effect if it has already been
+// called.
+func (b *TimeBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	b.schema.Marshaler = b.marshaler
+
+	b.addLimits()
+	b.addExamples()
+	b.addDocumentation()
+}
+
+// addLimits adds a constraint that enforces the minimum and maximum values, if
+// any.
+func (b *TimeBuilder) addLimits() {
+	min, hasMin := b.min.Get()
+	max, hasMax := b.max.Get()
+
+	if !hasMin && !hasMax {
+		return
+	}
+
+	if hasMin && hasMax && min.After(max) {
+		panic(fmt.Sprintf(
+			"minimum time (%s) must not be after the maximum time (%s)",
+			min.Format(time.RFC3339Nano),
+			max.Format(time.RFC3339Nano),
+		))
+	}
+
+	var req, expected string
+
+	switch {
+	case hasMin && hasMax:
+		req = fmt.Sprintf("**MUST** be between `%s` and `%s`", b.mustMarshalLimit("minimum", min), b.mustMarshalLimit("maximum", max))
+		expected = fmt.Sprintf("expected between %s and %s", b.mustMarshalLimit("minimum", min), b.mustMarshalLimit("maximum", max))
+	case hasMin:
+		req = fmt.Sprintf("**MUST** be `%s` or later", b.mustMarshalLimit("minimum", min))
+		expected = fmt.Sprintf("expected %s or later", b.mustMarshalLimit("minimum", min))
+	default:
+		req = fmt.Sprintf("**MUST** be `%s` or earlier", b.mustMarshalLimit("maximum", max))
+		expected = fmt.Sprintf("expected %s or earlier", b.mustMarshalLimit("maximum", max))
+	}
+
+	b.builder.BuiltInConstraint(
+		req,
+		func(v time.Time) variable.ConstraintError {
+			if hasMin && v.Before(min) {
+				return errors.New("too early, " + expected)
+			}
+
+			if hasMax && v.After(max) {
+				return errors.New("too late, " + expected)
+			}
+
+			return nil
+		},
+	)
+}
+
+// mustMarshalLimit returns the canonical representation of a limit, or panics
+// if it can not be represented using the canonical layout.
+func (b *TimeBuilder) mustMarshalLimit(which string, v time.Time) string {
+	lit, err := b.marshaler.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"%s time (%s) is invalid: %s",
+			which,
+			v.Format(time.RFC3339Nano),
+			err,
+		))
+	}
+	return lit.String
+}
+
+// addExamples adds examples of the minimum and maximum values, along with a
+// representative value within the permitted range.
+//
+// The representative value is omitted if there is a default value, which is
+// likely to be a better example.
+func (b *TimeBuilder) addExamples() {
+	min, hasMin := b.min.Get()
+	max, hasMax := b.max.Get()
+
+	if hasMin {
+		b.builder.NonNormativeExample(min, "the minimum accepted value")
+	}
+
+	if hasMax {
+		b.builder.NonNormativeExample(max, "the maximum accepted value")
+	}
+
+	if b.hasDefault {
+		return
+	}
+
+	var eg time.Time
+
+	switch {
+	case hasMin && hasMax:
+		eg = min.Add(max.Sub(min) / 2)
+	case hasMin:
+		eg = min.AddDate(1, 0, 0)
+	case hasMax:
+		eg = max.AddDate(-1, 0, 0)
+	default:
+		eg = time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	}
+
+	// Fall back to midnight (UTC) if the canonical layout can't represent the
+	// time of day.
+	if _, err := b.marshaler.Marshal(eg); err != nil {
+		eg = eg.UTC().Truncate(24 * time.Hour)
+	}
+
+	b.builder.NonNormativeExample(eg, "")
+}
+
+// addDocumentation adds documentation describing the accepted layouts.
+func (b *TimeBuilder) addDocumentation() {
+	layouts := b.marshaler.Layouts
+
+	d := b.builder.Documentation().
+		Summary("Timestamp syntax").
+		Paragraph("Timestamps are specified as %s.").
+		Format(layouts[0].describe())
+
+	if len(layouts) > 1 {
+		var alternatives []string
+		for _, l := range layouts[1:] {
+			alternatives = append(alternatives, l.describe())
+		}
+
+		d = d.
+			Paragraph("They may also be specified as %s.").
+			Format(strings.Join(alternatives, ", or as "))
+	}
+
+	d.Done()
+}
+
+// describe returns a human-readable description of the layout, for use in
+// documentation.
+func (l TimeLayout) describe() string {
+	switch l {
+	case RFC3339Layout:
+		return "an RFC 3339 date and time, such as `2006-01-02T15:04:05Z` or `2006-01-02T15:04:05.999+07:00`"
+	case DateLayout:
+		return "a date in ISO 8601 format (interpreted as midnight UTC), such as `2006-01-02`"
+	case UnixLayout:
+		return "a Unix timestamp in seconds, such as `1136214245`"
+	default:
+		panic(fmt.Sprintf("unsupported time layout (%d)", l))
+	}
+}
+
+// name returns a short name for the layout, for use in error messages.
+func (l TimeLayout) name() string {
+	switch l {
+	case RFC3339Layout:
+		return "an RFC 3339 timestamp"
+	case DateLayout:
+		return "a YYYY-MM-DD date"
+	case UnixLayout:
+		return "a Unix timestamp"
+	default:
+		panic(fmt.Sprintf("unsupported time layout (%d)", l))
+	}
+}
+
+// inlineList returns a human-readable list of the given items, such as "a, b
+// or c".
+func inlineList(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	}
+
+	return strings.Join(items[:len(items)-1], ", ") + " or " + items[len(items)-1]
+}
+
+type timeMarshaler struct {
+	Layouts []TimeLayout
+}
+
+func (m timeMarshaler) Marshal(v time.Time) (variable.Literal, error) {
+	switch m.Layouts[0] {
+	case RFC3339Layout:
+		return variable.Literal{
+			String: v.Format(time.RFC3339Nano),
+		}, nil
+
+	case DateLayout:
+		v = v.UTC()
+		if !v.Equal(v.Truncate(24 * time.Hour)) {
+			return variable.Literal{}, errors.New("timestamp must be at midnight UTC to be represented as a date")
+		}
+
+		return variable.Literal{
+			String: v.Format("2006-01-02"),
+		}, nil
+
+	default: // UnixLayout
+		if v.Nanosecond() != 0 {
+			return variable.Literal{}, errors.New("timestamp must be a whole number of seconds to be represented as a Unix timestamp")
+		}
+
+		return variable.Literal{
+			String: strconv.FormatInt(v.Unix(), 10),
+		}, nil
+	}
+}
+
+func (m timeMarshaler) Unmarshal(v variable.Literal) (time.Time, error) {
+	var names []string
+
+	for _, l := range m.Layouts {
+		t, err := l.parse(v.String)
+		if err == nil {
+			return t, nil
+		}
+
+		var parseErr *time.ParseError
+		if errors.As(err, &parseErr) && parseErr.Message != "" {
+			// The value is in the correct format but one of its components is
+			// out of range, such as a month of 13.
+			return time.Time{}, errors.New(
+				strings.TrimPrefix(parseErr.Message, ": "),
+			)
+		}
+
+		names = append(names, l.name())
+	}
+
+	return time.Time{}, fmt.Errorf(
+		"unrecognized timestamp, expected %s",
+		inlineList(names),
+	)
+}
+
+// parse parses a timestamp in this layout.
+func (l TimeLayout) parse(v string) (time.Time, error) {
+	switch l {
+	case RFC3339Layout:
+		return time.Parse(time.RFC3339, v)
+	case DateLayout:
+		return time.Parse("2006-01-02", v)
+	default: // UnixLayout
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(n, 0).UTC(), nil
+	}
+}
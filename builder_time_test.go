@@ -0,0 +1,310 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type TimeBuilder", func() {
+	var builder *TimeBuilder
+
+	BeforeEach(func() {
+		builder = Time("FERRITE_TIME", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Time("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Time("FERRITE_TIME", "").Optional()
+		}).To(PanicWith("specification for FERRITE_TIME is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value can not be represented in the canonical layout", func() {
+		Expect(func() {
+			builder.
+				WithLayouts(DateLayout).
+				WithDefault(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_TIME is invalid: default value: timestamp must be at midnight UTC to be represented as a date"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid timestamp", func() {
+			Describe("func Value()", func() {
+				It("returns the value", func() {
+					os.Setenv("FERRITE_TIME", "2006-01-02T15:04:05+07:00")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(BeTemporally("==", time.Date(2006, 1, 2, 8, 4, 5, 0, time.UTC)))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_TIME", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"unrecognized format",
+						"2006-01-02",
+						`value of FERRITE_TIME (2006-01-02) is invalid: unrecognized timestamp, expected an RFC 3339 timestamp`,
+					),
+					Entry(
+						"component out of range",
+						"2006-13-02T15:04:05Z",
+						`value of FERRITE_TIME (2006-13-02T15:04:05Z) is invalid: month out of range`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						expect := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+
+						v := builder.
+							WithDefault(expect).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_TIME is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("there are multiple layouts", func() {
+		DescribeTable(
+			"it accepts any of the layouts",
+			func(value string, expect time.Time) {
+				os.Setenv("FERRITE_TIME", value)
+
+				v := builder.
+					WithLayouts(RFC3339Layout, DateLayout, UnixLayout).
+					Required().
+					Value()
+
+				Expect(v).To(BeTemporally("==", expect))
+			},
+			Entry("RFC 3339", "2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+			Entry("date", "2006-01-02", time.Date(2006, 1, 2, 0, 0, 0, 0, time.UTC)),
+			Entry("unix", "1136214245", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)),
+		)
+
+		It("lists the layouts when the value is not recognized", func() {
+			os.Setenv("FERRITE_TIME", "yesterday")
+
+			Expect(func() {
+				builder.
+					WithLayouts(DateLayout, UnixLayout).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_TIME (yesterday) is invalid: unrecognized timestamp, expected a YYYY-MM-DD date or a Unix timestamp`,
+			))
+		})
+	})
+
+	When("the value is lower than the minimum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_TIME", "2005-12-31")
+
+				builder.
+					WithLayouts(DateLayout).
+					WithMinimum(time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC)).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_TIME (2005-12-31) is invalid: too early, expected 2006-01-01 or later`,
+			))
+		})
+	})
+
+	When("the value is greater than the maximum limit", func() {
+		It("panics", func() {
+			Expect(func() {
+				os.Setenv("FERRITE_TIME", "1136214245")
+
+				builder.
+					WithLayouts(UnixLayout).
+					WithMaximum(time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC)).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_TIME (1136214245) is invalid: too late, expected 1136073600 or earlier`,
+			))
+		})
+	})
+})
+
+func ExampleTime_required() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example timestamp variable").
+		Required()
+
+	os.Setenv("FERRITE_TIME", "2006-01-02T15:04:05Z")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2006-01-02 15:04:05 +0000 UTC
+}
+
+func ExampleTime_default() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example timestamp variable").
+		WithDefault(time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2006-01-02 15:04:05 +0000 UTC
+}
+
+func ExampleTime_optional() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example timestamp variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleTime_layouts() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example timestamp variable").
+		WithLayouts(ferrite.DateLayout, ferrite.UnixLayout).
+		Required()
+
+	os.Setenv("FERRITE_TIME", "1136160000")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2006-01-02 00:00:00 +0000 UTC
+}
+
+func ExampleTime_limits() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example timestamp variable").
+		WithMinimum(time.Date(2006, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WithMaximum(time.Date(2006, 12, 31, 0, 0, 0, 0, time.UTC)).
+		Required()
+
+	os.Setenv("FERRITE_TIME", "2006-01-02T15:04:05Z")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2006-01-02 15:04:05 +0000 UTC
+}
+
+func ExampleTime_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_TIME", "1136214245")
+	v := ferrite.
+		Time("FERRITE_TIME", "example timestamp variable").
+		WithLayouts(ferrite.RFC3339Layout, ferrite.UnixLayout).
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_TIME  example timestamp variable  [ <string> ]  ⚠ deprecated variable set to 1136214245, equivalent to 2006-01-02T15:04:05Z
+	//
+	// value is 2006-01-02 15:04:05 +0000 UTC
+}
@@ -0,0 +1,149 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// TimeZone configures an environment variable as a time zone.
+//
+// The value is the name of a location in the IANA Time Zone Database, such as
+// "Europe/London", or one of the special values "UTC" and "Local". Locations
+// are loaded using time.LoadLocation(), which uses the system's zoneinfo
+// database. Import the time/tzdata package to embed a copy of the database
+// within the application for systems that do not have one installed.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func TimeZone(name, desc string) *TimeZoneBuilder {
+	b := &TimeZoneBuilder{
+		schema: variable.TypedOther[*time.Location]{
+			Marshaler: timeZoneMarshaler{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	b.builder.NormativeExample(time.UTC, "Coordinated Universal Time")
+	b.builder.NormativeExample(time.Local, "the system's local time zone")
+
+	// The location-based example is omitted if the zoneinfo database is not
+	// available, rather than substituting a value that is not really the
+	// location it claims to be.
+	if loc, err := time.LoadLocation("Europe/London"); err == nil {
+		b.builder.NormativeExample(loc, "a location-based time zone")
+	}
+
+	return b
+}
+
+// TimeZoneBuilder builds a specification for a time zone variable.
+type TimeZoneBuilder struct {
+	schema    variable.TypedOther[*time.Location]
+	builder   variable.TypedSpecBuilder[*time.Location]
+	completed bool
+}
+
+var _ isBuilderOf[*time.Location, *TimeZoneBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *TimeZoneBuilder) WithDefault(v *time.Location) *TimeZoneBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *TimeZoneBuilder) Required(options ...RequiredOption) Required[*time.Location] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *TimeZoneBuilder) Optional(options ...OptionalOption) Optional[*time.Location] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *TimeZoneBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*time.Location] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *TimeZoneBuilder) element() (variable.TypedSchema[*time.Location], *variable.TypedSpecBuilder[*time.Location]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints and documentation that depend on the builder's
+// options.
+//
+// It has no effect if it has already been called.
+func (b *TimeZoneBuilder) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	b.schema.Requirement = "**MUST** be a valid time zone name"
+
+	b.builder.Documentation().
+		Summary("Time zone names").
+		Paragraph(
+			"Time zones are specified using the name of a location in the IANA Time Zone Database,",
+			"such as `Europe/London` or `America/New_York`.",
+			"Names are case sensitive.",
+		).
+		Format().
+		Paragraph(
+			"The special value `UTC` refers to Coordinated Universal Time,",
+			"and `Local` refers to the time zone configured on the host system.",
+			"Fixed offsets such as `+10:00` and abbreviations such as `AEST` are not supported.",
+		).
+		Format().
+		Done()
+}
+
+type timeZoneMarshaler struct{}
+
+func (timeZoneMarshaler) Marshal(v *time.Location) (variable.Literal, error) {
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (timeZoneMarshaler) Unmarshal(v variable.Literal) (*time.Location, error) {
+	switch v.String {
+	case "UTC":
+		return time.UTC, nil
+	case "Local":
+		return time.Local, nil
+	}
+
+	if strings.HasPrefix(v.String, "+") || strings.HasPrefix(v.String, "-") {
+		return nil, errors.New("fixed offsets are not supported, expected a time zone name such as Europe/London")
+	}
+
+	loc, err := time.LoadLocation(v.String)
+	if err == nil {
+		return loc, nil
+	}
+
+	for _, n := range []string{"UTC", "Local"} {
+		if strings.EqualFold(v.String, n) {
+			return nil, fmt.Errorf("unknown time zone, names are case-sensitive, did you mean %s?", n)
+		}
+	}
+
+	return nil, errors.New("unknown time zone, expected a name from the IANA Time Zone Database such as Europe/London")
+}
@@ -0,0 +1,229 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type TimeZoneBuilder", func() {
+	var builder *TimeZoneBuilder
+
+	BeforeEach(func() {
+		builder = TimeZone("FERRITE_TIME_ZONE", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			TimeZone("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			TimeZone("FERRITE_TIME_ZONE", "").Optional()
+		}).To(PanicWith("specification for FERRITE_TIME_ZONE is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid time zone", func() {
+			Describe("func Value()", func() {
+				It("returns the location", func() {
+					os.Setenv("FERRITE_TIME_ZONE", "Europe/London")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v.String()).To(Equal("Europe/London"))
+				})
+
+				It("returns time.UTC for UTC", func() {
+					os.Setenv("FERRITE_TIME_ZONE", "UTC")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(BeIdenticalTo(time.UTC))
+				})
+
+				It("returns time.Local for Local", func() {
+					os.Setenv("FERRITE_TIME_ZONE", "Local")
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v).To(BeIdenticalTo(time.Local))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_TIME_ZONE", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"unknown time zone",
+						"Europe/Atlantis",
+						`value of FERRITE_TIME_ZONE (Europe/Atlantis) is invalid: unknown time zone, expected a name from the IANA Time Zone Database such as Europe/London`,
+					),
+					Entry(
+						"incorrect case of UTC",
+						"utc",
+						`value of FERRITE_TIME_ZONE (utc) is invalid: unknown time zone, names are case-sensitive, did you mean UTC?`,
+					),
+					Entry(
+						"incorrect case of Local",
+						"LOCAL",
+						`value of FERRITE_TIME_ZONE (LOCAL) is invalid: unknown time zone, names are case-sensitive, did you mean Local?`,
+					),
+					Entry(
+						"fixed offset",
+						"+10:00",
+						`value of FERRITE_TIME_ZONE (+10:00) is invalid: fixed offsets are not supported, expected a time zone name such as Europe/London`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(time.UTC).
+							Required().
+							Value()
+
+						Expect(v).To(BeIdenticalTo(time.UTC))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_TIME_ZONE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleTimeZone_required() {
+	defer example()()
+
+	v := ferrite.
+		TimeZone("FERRITE_TIME_ZONE", "example time zone variable").
+		Required()
+
+	os.Setenv("FERRITE_TIME_ZONE", "Europe/London")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is Europe/London
+}
+
+func ExampleTimeZone_default() {
+	defer example()()
+
+	v := ferrite.
+		TimeZone("FERRITE_TIME_ZONE", "example time zone variable").
+		WithDefault(time.UTC).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is UTC
+}
+
+func ExampleTimeZone_optional() {
+	defer example()()
+
+	v := ferrite.
+		TimeZone("FERRITE_TIME_ZONE", "example time zone variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleTimeZone_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_TIME_ZONE", "America/New_York")
+	v := ferrite.
+		TimeZone("FERRITE_TIME_ZONE", "example time zone variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_TIME_ZONE  example time zone variable  [ <string> ]  ⚠ deprecated variable set to America/New_York
+	//
+	// value is America/New_York
+}
@@ -0,0 +1,593 @@
+package ferrite
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// TLS configures environment variables used to build a TLS configuration.
+//
+// prefix is a prefix applied to the name of each of the environment variables,
+// such as "TLS" or "HTTPS". The following variables are used:
+//
+//   - "<prefix>_CERT_FILE" is the path to a PEM file containing the certificate
+//     chain.
+//   - "<prefix>_KEY_FILE" is the path to a PEM file containing the private key
+//     of the certificate.
+//   - "<prefix>_CA_FILE" is the path to a PEM file containing the certificate
+//     authorities used to verify server certificates. It is always optional.
+//   - "<prefix>_CLIENT_CA_FILE" is the path to a PEM file containing the
+//     certificate authorities used to verify client certificates. It is always
+//     optional.
+//   - "<prefix>_MIN_VERSION" is the minimum TLS version, which defaults to 1.2.
+//   - "<prefix>_CLIENT_AUTH" is the server's policy for client certificate
+//     authentication, which defaults to "none".
+//
+// The certificate and private key are loaded and checked against each other
+// when the environment is validated.
+func TLS(prefix string) *TLSBuilder {
+	if prefix == "" {
+		panic("TLS variable prefix must not be empty")
+	}
+
+	b := &TLSBuilder{
+		minVersionSchema: variable.TypedSet[uint16]{
+			ToLiteral: func(v uint16) variable.Literal {
+				return variable.Literal{
+					String: tlsVersionNames[v],
+				}
+			},
+		},
+		clientAuthSchema: variable.TypedSet[tls.ClientAuthType]{
+			ToLiteral: func(v tls.ClientAuthType) variable.Literal {
+				return variable.Literal{
+					String: tlsClientAuthNames[v],
+				}
+			},
+		},
+	}
+
+	b.certBuilder.Name(prefix + "_CERT_FILE")
+	b.certBuilder.Description("path to the TLS certificate chain")
+	b.certBuilder.NonNormativeExample("/path/to/cert.pem", "an absolute file path")
+	b.certBuilder.BuiltInRuntimeConstraint(
+		"**MUST** be the path to a readable PEM file containing an X.509 certificate chain",
+		func(n FileName) variable.ConstraintError {
+			_, err := readTLSCertificates(n)
+			return err
+		},
+	)
+	b.certBuilder.Documentation().
+		Summary("Certificate chain format").
+		Paragraph(
+			"The file must contain one or more PEM encoded X.509 certificates.",
+			"The first certificate is the leaf certificate,",
+			"which is followed by any intermediate certificates that are required to verify it.",
+		).
+		Format().
+		Done()
+
+	b.keyBuilder.Name(prefix + "_KEY_FILE")
+	b.keyBuilder.Description("path to the private key of the TLS certificate")
+	b.keyBuilder.NonNormativeExample("/path/to/key.pem", "an absolute file path")
+	b.keyBuilder.BuiltInRuntimeConstraint(
+		fmt.Sprintf(
+			"**MUST** be the path to a readable PEM file containing the private key of the certificate in `%s`",
+			prefix+"_CERT_FILE",
+		),
+		func(n FileName) variable.ConstraintError {
+			return b.checkKey(n)
+		},
+	)
+	b.keyBuilder.Documentation().
+		Summary("Private key format").
+		Paragraph(
+			"The file must contain a single unencrypted PEM encoded private key",
+			"in PKCS #1, PKCS #8 or SEC 1 form.",
+		).
+		Format().
+		Done()
+
+	b.caBuilder.Name(prefix + "_CA_FILE")
+	b.caBuilder.Description("path to the certificate authorities used to verify TLS servers")
+	b.caBuilder.NonNormativeExample("/path/to/ca.pem", "an absolute file path")
+	b.caBuilder.BuiltInRuntimeConstraint(
+		"**MUST** be the path to a readable PEM file containing one or more X.509 certificates",
+		func(n FileName) variable.ConstraintError {
+			_, err := readTLSCertificatePool(n)
+			return err
+		},
+	)
+	b.caBuilder.Documentation().
+		Paragraph(
+			"If this variable is undefined the host system's certificate authorities are used.",
+		).
+		Format().
+		Important().
+		Done()
+
+	b.clientCABuilder.Name(prefix + "_CLIENT_CA_FILE")
+	b.clientCABuilder.Description("path to the certificate authorities used to verify TLS clients")
+	b.clientCABuilder.NonNormativeExample("/path/to/client-ca.pem", "an absolute file path")
+	b.clientCABuilder.BuiltInRuntimeConstraint(
+		"**MUST** be the path to a readable PEM file containing one or more X.509 certificates",
+		func(n FileName) variable.ConstraintError {
+			_, err := readTLSCertificatePool(n)
+			return err
+		},
+	)
+	b.clientCABuilder.Documentation().
+		Paragraph(
+			"If this variable is undefined the host system's certificate authorities are used.",
+		).
+		Format().
+		Important().
+		Done()
+
+	b.minVersionBuilder.Name(prefix + "_MIN_VERSION")
+	b.minVersionBuilder.Description("minimum TLS version")
+	b.minVersionBuilder.Default(tls.VersionTLS12)
+	for _, v := range []uint16{tls.VersionTLS10, tls.VersionTLS11, tls.VersionTLS12, tls.VersionTLS13} {
+		b.minVersionSchema.Members = append(
+			b.minVersionSchema.Members,
+			variable.SetMember[uint16]{
+				Value:       v,
+				Description: "TLS " + tlsVersionNames[v],
+			},
+		)
+	}
+
+	b.clientAuthBuilder.Name(prefix + "_CLIENT_AUTH")
+	b.clientAuthBuilder.Description("TLS client certificate authentication policy")
+	b.clientAuthBuilder.Default(tls.NoClientCert)
+	for _, m := range []struct {
+		Value tls.ClientAuthType
+		Desc  string
+	}{
+		{tls.NoClientCert, "client certificates are not requested"},
+		{tls.RequestClientCert, "client certificates are requested but not required or verified"},
+		{tls.RequireAnyClientCert, "client certificates are required but not verified"},
+		{tls.VerifyClientCertIfGiven, "client certificates are verified if they are provided"},
+		{tls.RequireAndVerifyClientCert, "client certificates are required and verified"},
+	} {
+		b.clientAuthSchema.Members = append(
+			b.clientAuthSchema.Members,
+			variable.SetMember[tls.ClientAuthType]{
+				Value:       m.Value,
+				Description: m.Desc,
+			},
+		)
+	}
+
+	variable.EstablishRelationships(
+		variable.RefersTo{
+			Subject:  b.certBuilder.Peek(),
+			RefersTo: b.keyBuilder.Peek(),
+		},
+		variable.RefersTo{
+			Subject:  b.keyBuilder.Peek(),
+			RefersTo: b.certBuilder.Peek(),
+		},
+		variable.RefersTo{
+			Subject:  b.clientCABuilder.Peek(),
+			RefersTo: b.clientAuthBuilder.Peek(),
+		},
+		variable.RefersTo{
+			Subject:  b.minVersionBuilder.Peek(),
+			RefersTo: b.certBuilder.Peek(),
+		},
+		variable.RefersTo{
+			Subject:  b.clientAuthBuilder.Peek(),
+			RefersTo: b.clientCABuilder.Peek(),
+		},
+	)
+
+	return b
+}
+
+// TLSBuilder is the specification for a TLS configuration.
+type TLSBuilder struct {
+	minVersionSchema  variable.TypedSet[uint16]
+	clientAuthSchema  variable.TypedSet[tls.ClientAuthType]
+	certBuilder       variable.TypedSpecBuilder[FileName]
+	keyBuilder        variable.TypedSpecBuilder[FileName]
+	caBuilder         variable.TypedSpecBuilder[FileName]
+	clientCABuilder   variable.TypedSpecBuilder[FileName]
+	minVersionBuilder variable.TypedSpecBuilder[uint16]
+	clientAuthBuilder variable.TypedSpecBuilder[tls.ClientAuthType]
+
+	// cert is the variable containing the path to the certificate chain. It
+	// is used to check that the private key matches the certificate. It is nil
+	// until the variables are registered.
+	cert *variable.OfType[FileName]
+}
+
+var _ isBuilderOf[*tls.Config, *TLSBuilder]
+
+// WithDefault sets the paths of the certificate chain and private key files
+// to use when the environment variables are undefined.
+func (b *TLSBuilder) WithDefault(certFile, keyFile string) *TLSBuilder {
+	b.certBuilder.Default(FileName(certFile))
+	b.keyBuilder.Default(FileName(keyFile))
+	return b
+}
+
+// WithDefaultCA sets the path of the file containing the certificate
+// authorities used to verify server certificates to use when the environment
+// variable is undefined.
+func (b *TLSBuilder) WithDefaultCA(caFile string) *TLSBuilder {
+	b.caBuilder.Default(FileName(caFile))
+	return b
+}
+
+// WithDefaultClientCA sets the path of the file containing the certificate
+// authorities used to verify client certificates to use when the environment
+// variable is undefined.
+func (b *TLSBuilder) WithDefaultClientCA(caFile string) *TLSBuilder {
+	b.clientCABuilder.Default(FileName(caFile))
+	return b
+}
+
+// WithDefaultMinVersion sets the minimum TLS version to use when the
+// environment variable is undefined, such as tls.VersionTLS13.
+func (b *TLSBuilder) WithDefaultMinVersion(v uint16) *TLSBuilder {
+	if _, ok := tlsVersionNames[v]; !ok {
+		panic(fmt.Sprintf("unsupported TLS version (%#04x)", v))
+	}
+
+	b.minVersionBuilder.Default(v)
+	return b
+}
+
+// WithDefaultClientAuth sets the client certificate authentication policy to
+// use when the environment variable is undefined.
+func (b *TLSBuilder) WithDefaultClientAuth(v tls.ClientAuthType) *TLSBuilder {
+	if _, ok := tlsClientAuthNames[v]; !ok {
+		panic(fmt.Sprintf("unsupported TLS client authentication policy (%d)", v))
+	}
+
+	b.clientAuthBuilder.Default(v)
+	return b
+}
+
+// Required completes the build process and registers required variables with
+// Ferrite's validation system.
+//
+// The certificate authorities files are always optional.
+func (b *TLSBuilder) Required(options ...RequiredOption) Required[*tls.Config] {
+	b.certBuilder.MarkRequired()
+	b.keyBuilder.MarkRequired()
+	b.minVersionBuilder.MarkRequired()
+	b.clientAuthBuilder.MarkRequired()
+
+	var cfg variableSetConfig
+	for _, opt := range options {
+		opt.applyRequiredOptionToConfig(&cfg)
+		for _, sb := range b.specBuilders() {
+			opt.applyRequiredOptionToSpec(sb)
+		}
+	}
+
+	vars := b.register(cfg.Registry)
+
+	return requiredFunc[*tls.Config]{
+		vars.all(),
+		func() (*tls.Config, error) {
+			for _, v := range vars.all() {
+				if err := v.Error(); err != nil {
+					return nil, err
+				}
+			}
+
+			return vars.config()
+		},
+	}
+}
+
+// Optional completes the build process and registers optional variables with
+// Ferrite's validation system.
+func (b *TLSBuilder) Optional(options ...OptionalOption) Optional[*tls.Config] {
+	var cfg variableSetConfig
+	for _, opt := range options {
+		opt.applyOptionalOptionToConfig(&cfg)
+		for _, sb := range b.specBuilders() {
+			opt.applyOptionalOptionToSpec(sb)
+		}
+	}
+
+	vars := b.register(cfg.Registry)
+
+	return optionalFunc[*tls.Config]{
+		vars.all(),
+		vars.optionalResolver,
+	}
+}
+
+// Deprecated completes the build process and registers deprecated variables
+// with Ferrite's validation system.
+func (b *TLSBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*tls.Config] {
+	for _, sb := range b.specBuilders() {
+		sb.MarkDeprecated()
+	}
+
+	var cfg variableSetConfig
+	for _, opt := range options {
+		opt.applyDeprecatedOptionToConfig(&cfg)
+		for _, sb := range b.specBuilders() {
+			opt.applyDeprecatedOptionToSpec(sb)
+		}
+	}
+
+	vars := b.register(cfg.Registry)
+
+	return deprecatedFunc[*tls.Config]{
+		vars.all(),
+		vars.optionalResolver,
+	}
+}
+
+// specBuilders returns the spec builders for each of the variables.
+func (b *TLSBuilder) specBuilders() []variable.SpecBuilder {
+	return []variable.SpecBuilder{
+		&b.certBuilder,
+		&b.keyBuilder,
+		&b.caBuilder,
+		&b.clientCABuilder,
+		&b.minVersionBuilder,
+		&b.clientAuthBuilder,
+	}
+}
+
+// register registers each of the variables with reg.
+func (b *TLSBuilder) register(reg *variable.Registry) tlsVariables {
+	vars := tlsVariables{
+		Cert:       variable.Register(reg, b.certBuilder.Done(variable.TypedString[FileName]{})),
+		Key:        variable.Register(reg, b.keyBuilder.Done(variable.TypedString[FileName]{})),
+		CA:         variable.Register(reg, b.caBuilder.Done(variable.TypedString[FileName]{})),
+		ClientCA:   variable.Register(reg, b.clientCABuilder.Done(variable.TypedString[FileName]{})),
+		MinVersion: variable.Register(reg, b.minVersionBuilder.Done(b.minVersionSchema)),
+		ClientAuth: variable.Register(reg, b.clientAuthBuilder.Done(b.clientAuthSchema)),
+	}
+
+	b.cert = vars.Cert
+
+	return vars
+}
+
+// checkKey returns an error if the file at n does not contain a private key
+// that matches the certificate in the certificate chain variable.
+func (b *TLSBuilder) checkKey(n FileName) error {
+	keyPEM, err := readTLSFile(n)
+	if err != nil {
+		return err
+	}
+
+	// Only check the private key on its own if the certificate is unavailable,
+	// as any problem with the certificate is reported against the certificate
+	// variable itself.
+	if b.cert == nil || b.cert.Availability() != variable.AvailabilityOK {
+		return checkTLSPrivateKey(keyPEM)
+	}
+
+	certPEM, err := readTLSFile(b.cert.NativeValue())
+	if err != nil {
+		return checkTLSPrivateKey(keyPEM)
+	}
+
+	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		if err := checkTLSPrivateKey(keyPEM); err != nil {
+			return err
+		}
+
+		return fmt.Errorf(
+			"private key does not match the certificate in %s",
+			b.cert.Spec().Name(),
+		)
+	}
+
+	return nil
+}
+
+// tlsVariables is the set of variables that describe a TLS configuration.
+type tlsVariables struct {
+	Cert, Key, CA, ClientCA *variable.OfType[FileName]
+	MinVersion              *variable.OfType[uint16]
+	ClientAuth              *variable.OfType[tls.ClientAuthType]
+}
+
+// all returns all of the variables in the set.
+func (v tlsVariables) all() []variable.Any {
+	return []variable.Any{v.Cert, v.Key, v.CA, v.ClientCA, v.MinVersion, v.ClientAuth}
+}
+
+// config returns the TLS configuration described by the variables.
+func (v tlsVariables) config() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(
+		string(v.Cert.NativeValue()),
+		string(v.Key.NativeValue()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   v.MinVersion.NativeValue(),
+		ClientAuth:   v.ClientAuth.NativeValue(),
+	}
+
+	if v.CA.Availability() == variable.AvailabilityOK {
+		pool, err := readTLSCertificatePool(v.CA.NativeValue())
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.RootCAs = pool
+	}
+
+	if v.ClientCA.Availability() == variable.AvailabilityOK {
+		pool, err := readTLSCertificatePool(v.ClientCA.NativeValue())
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.ClientCAs = pool
+	}
+
+	return cfg, nil
+}
+
+// optionalResolver returns the TLS configuration described by the variables,
+// if both the certificate chain and private key are available.
+func (v tlsVariables) optionalResolver() (*tls.Config, bool, error) {
+	for _, x := range v.all() {
+		if err := x.Error(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	availability := v.Cert.Availability()
+
+	if v.Key.Availability() != availability {
+		var def, undef variable.Any = v.Cert, v.Key
+		if availability != variable.AvailabilityOK {
+			def, undef = undef, def
+		}
+
+		return nil, false, fmt.Errorf(
+			"%s is defined but %s is not, define both or neither",
+			def.Spec().Name(),
+			undef.Spec().Name(),
+		)
+	}
+
+	if availability != variable.AvailabilityOK {
+		return nil, false, nil
+	}
+
+	cfg, err := v.config()
+	return cfg, err == nil, err
+}
+
+// tlsVersionNames is a map of TLS version to its name.
+var tlsVersionNames = map[uint16]string{
+	tls.VersionTLS10: "1.0",
+	tls.VersionTLS11: "1.1",
+	tls.VersionTLS12: "1.2",
+	tls.VersionTLS13: "1.3",
+}
+
+// tlsClientAuthNames is a map of client authentication policy to its name.
+var tlsClientAuthNames = map[tls.ClientAuthType]string{
+	tls.NoClientCert:               "none",
+	tls.RequestClientCert:          "request",
+	tls.RequireAnyClientCert:       "require",
+	tls.VerifyClientCertIfGiven:    "verify-if-given",
+	tls.RequireAndVerifyClientCert: "require-and-verify",
+}
+
+// readTLSFile returns the content of a PEM file referred to by a TLS variable.
+func readTLSFile(n FileName) ([]byte, error) {
+	if err := (pathRequirements{Read: true}).check(string(n), false); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(string(n))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file: %w", unwrapPathError(err))
+	}
+
+	return data, nil
+}
+
+// readTLSCertificates returns the certificates in the PEM file at n.
+func readTLSCertificates(n FileName) ([]*x509.Certificate, error) {
+	data, err := readTLSFile(n)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []*x509.Certificate
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate #%d is invalid: %w", len(certs)+1, err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("file does not contain any PEM encoded certificates")
+	}
+
+	return certs, nil
+}
+
+// readTLSCertificatePool returns a certificate pool containing the
+// certificates in the PEM file at n.
+func readTLSCertificatePool(n FileName) (*x509.CertPool, error) {
+	certs, err := readTLSCertificates(n)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	for _, c := range certs {
+		pool.AddCert(c)
+	}
+
+	return pool, nil
+}
+
+// checkTLSPrivateKey returns an error if data does not contain a valid PEM
+// encoded private key.
+func checkTLSPrivateKey(data []byte) error {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return errors.New("file does not contain a PEM encoded private key")
+		}
+
+		if block.Type != "PRIVATE KEY" && !strings.HasSuffix(block.Type, " PRIVATE KEY") {
+			continue
+		}
+
+		if _, ok := block.Headers["Proc-Type"]; ok {
+			return errors.New("private key must not be encrypted")
+		}
+
+		if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+			return nil
+		}
+
+		if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return nil
+		}
+
+		if _, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+			return nil
+		}
+
+		return errors.New("private key is not in PKCS #1, PKCS #8 or SEC 1 form")
+	}
+}
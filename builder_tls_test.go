@@ -0,0 +1,314 @@
+package ferrite_test
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type TLSBuilder", func() {
+	var builder *TLSBuilder
+
+	BeforeEach(func() {
+		builder = TLS("FERRITE_TLS")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the prefix is empty", func() {
+		Expect(func() {
+			TLS("")
+		}).To(PanicWith("TLS variable prefix must not be empty"))
+	})
+
+	It("panics if the default minimum version is not supported", func() {
+		Expect(func() {
+			builder.WithDefaultMinVersion(0x0300)
+		}).To(PanicWith("unsupported TLS version (0x0300)"))
+	})
+
+	It("panics if the default client authentication policy is not supported", func() {
+		Expect(func() {
+			builder.WithDefaultClientAuth(tls.ClientAuthType(99))
+		}).To(PanicWith("unsupported TLS client authentication policy (99)"))
+	})
+
+	It("does not read the files when the variables are built", func() {
+		Expect(func() {
+			builder.
+				WithDefault("testdata/does-not-exist", "testdata/does-not-exist").
+				Optional()
+		}).NotTo(Panic())
+	})
+
+	It("establishes relationships between the variables", func() {
+		reg := &variable.Registry{}
+		builder.Required(WithRegistry(reg))
+
+		var cert, key variable.Spec
+		for _, v := range reg.Variables() {
+			switch v.Spec().Name() {
+			case "FERRITE_TLS_CERT_FILE":
+				cert = v.Spec()
+			case "FERRITE_TLS_KEY_FILE":
+				key = v.Spec()
+			}
+		}
+
+		Expect(variable.Relationships[variable.RefersTo](cert)).To(ContainElement(
+			variable.RefersTo{Subject: cert, RefersTo: key},
+		))
+		Expect(variable.Relationships[variable.RefersTo](key)).To(ContainElement(
+			variable.RefersTo{Subject: key, RefersTo: cert},
+		))
+	})
+
+	When("the variables are required", func() {
+		When("the certificate and key are valid", func() {
+			Describe("func Value()", func() {
+				It("returns a TLS configuration", func() {
+					os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/cert.pem")
+					os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/key.pem")
+
+					cfg := builder.
+						Required().
+						Value()
+
+					Expect(cfg.Certificates).To(HaveLen(1))
+					Expect(cfg.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+					Expect(cfg.ClientAuth).To(Equal(tls.NoClientCert))
+					Expect(cfg.RootCAs).To(BeNil())
+					Expect(cfg.ClientCAs).To(BeNil())
+				})
+
+				It("uses the certificate authorities, minimum version and client authentication policy", func() {
+					os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/cert.pem")
+					os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/key.pem")
+					os.Setenv("FERRITE_TLS_CA_FILE", "testdata/tls/cert.pem")
+					os.Setenv("FERRITE_TLS_CLIENT_CA_FILE", "testdata/tls/cert.pem")
+					os.Setenv("FERRITE_TLS_MIN_VERSION", "1.3")
+					os.Setenv("FERRITE_TLS_CLIENT_AUTH", "require-and-verify")
+
+					cfg := builder.
+						Required().
+						Value()
+
+					Expect(cfg.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+					Expect(cfg.ClientAuth).To(Equal(tls.RequireAndVerifyClientCert))
+					Expect(cfg.RootCAs).NotTo(BeNil())
+					Expect(cfg.ClientCAs).NotTo(BeNil())
+				})
+
+				It("uses separate certificate authorities for servers and clients", func() {
+					os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/cert.pem")
+					os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/key.pem")
+					os.Setenv("FERRITE_TLS_CA_FILE", "testdata/tls/cert.pem")
+
+					cfg := builder.
+						Required().
+						Value()
+
+					Expect(cfg.RootCAs).NotTo(BeNil())
+					Expect(cfg.ClientCAs).To(BeNil())
+				})
+			})
+		})
+
+		When("the files are invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(cert, key, expect string) {
+						os.Setenv("FERRITE_TLS_CERT_FILE", cert)
+						os.Setenv("FERRITE_TLS_KEY_FILE", key)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"certificate file does not exist",
+						"testdata/does-not-exist",
+						"testdata/tls/key.pem",
+						`value of FERRITE_TLS_CERT_FILE (testdata/does-not-exist) is invalid: file does not exist`,
+					),
+					Entry(
+						"certificate file does not contain any certificates",
+						"testdata/hello.txt",
+						"testdata/tls/key.pem",
+						`value of FERRITE_TLS_CERT_FILE (testdata/hello.txt) is invalid: file does not contain any PEM encoded certificates`,
+					),
+					Entry(
+						"key file does not contain a private key",
+						"testdata/tls/cert.pem",
+						"testdata/hello.txt",
+						`value of FERRITE_TLS_KEY_FILE (testdata/hello.txt) is invalid: file does not contain a PEM encoded private key`,
+					),
+					Entry(
+						"key does not match the certificate",
+						"testdata/tls/cert.pem",
+						"testdata/tls/other-key.pem",
+						`value of FERRITE_TLS_KEY_FILE (testdata/tls/other-key.pem) is invalid: private key does not match the certificate in FERRITE_TLS_CERT_FILE`,
+					),
+				)
+
+				It("panics if the minimum version is not supported", func() {
+					os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/cert.pem")
+					os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/key.pem")
+					os.Setenv("FERRITE_TLS_MIN_VERSION", "1.4")
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(`value of FERRITE_TLS_MIN_VERSION (1.4) is invalid: expected 1.0, 1.1, 1.2 or 1.3`))
+				})
+			})
+		})
+
+		When("the certificate and key are empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("uses the default files", func() {
+						cfg := builder.
+							WithDefault("testdata/tls/cert.pem", "testdata/tls/key.pem").
+							WithDefaultCA("testdata/tls/cert.pem").
+							WithDefaultClientCA("testdata/tls/cert.pem").
+							WithDefaultMinVersion(tls.VersionTLS13).
+							WithDefaultClientAuth(tls.VerifyClientCertIfGiven).
+							Required().
+							Value()
+
+						Expect(cfg.Certificates).To(HaveLen(1))
+						Expect(cfg.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+						Expect(cfg.ClientAuth).To(Equal(tls.VerifyClientCertIfGiven))
+						Expect(cfg.RootCAs).NotTo(BeNil())
+						Expect(cfg.ClientCAs).NotTo(BeNil())
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_TLS_CERT_FILE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variables are optional", func() {
+		When("neither the certificate nor the key is defined", func() {
+			Describe("func Value()", func() {
+				It("returns with ok == false", func() {
+					_, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeFalse())
+				})
+			})
+		})
+
+		When("only one of the certificate and key is defined", func() {
+			Describe("func Value()", func() {
+				It("panics", func() {
+					os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/cert.pem")
+
+					Expect(func() {
+						builder.
+							Optional().
+							Value()
+					}).To(PanicWith(
+						"FERRITE_TLS_CERT_FILE is defined but FERRITE_TLS_KEY_FILE is not, define both or neither",
+					))
+				})
+			})
+		})
+
+		When("both the certificate and key are defined", func() {
+			Describe("func Value()", func() {
+				It("returns a TLS configuration", func() {
+					os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/cert.pem")
+					os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/key.pem")
+
+					cfg, ok := builder.
+						Optional().
+						Value()
+
+					Expect(ok).To(BeTrue())
+					Expect(cfg.Certificates).To(HaveLen(1))
+				})
+			})
+		})
+	})
+
+	When("the variables are deprecated", func() {
+		Describe("func Value()", func() {
+			It("returns with ok == false when the certificate and key are undefined", func() {
+				_, ok := builder.
+					Deprecated().
+					DeprecatedValue()
+
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+})
+
+func ExampleTLS_required() {
+	defer example()()
+
+	v := ferrite.
+		TLS("FERRITE_TLS").
+		Required()
+
+	os.Setenv("FERRITE_TLS_CERT_FILE", "testdata/tls/cert.pem")
+	os.Setenv("FERRITE_TLS_KEY_FILE", "testdata/tls/key.pem")
+	os.Setenv("FERRITE_TLS_MIN_VERSION", "1.3")
+	ferrite.Init()
+
+	cfg := v.Value()
+	fmt.Println("number of certificates is", len(cfg.Certificates))
+	fmt.Println("minimum version is TLS 1.3:", cfg.MinVersion == tls.VersionTLS13)
+
+	// Output:
+	// number of certificates is 1
+	// minimum version is TLS 1.3: true
+}
+
+func ExampleTLS_optional() {
+	defer example()()
+
+	v := ferrite.
+		TLS("FERRITE_TLS").
+		Optional()
+
+	ferrite.Init()
+
+	if _, ok := v.Value(); ok {
+		fmt.Println("TLS is enabled")
+	} else {
+		fmt.Println("TLS is disabled")
+	}
+
+	// Output:
+	// TLS is disabled
+}
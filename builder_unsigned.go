@@ -31,11 +31,11 @@ func Unsigned[T constraints.Unsigned](name, desc string) *UnsignedBuilder[T] {
 		).
 		Format().
 		Paragraph(
-			"Internally, the `%s` variable is represented using an unsigned %d-bit integer type (`%s`);",
+			"Internally, %s is represented using an unsigned %d-bit integer type (`%s`);",
 			"any value that overflows this data-type is invalid.",
 		).
 		Format(
-			name,
+			documentationSubject(name),
 			reflectx.BitSize[T](),
 			reflectx.KindOf[T](),
 		).
@@ -90,6 +90,10 @@ func (b *UnsignedBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *UnsignedBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
+
 type unsignedMarshaler[T constraints.Unsigned] struct{}
 
 func (unsignedMarshaler[T]) Marshal(v T) (variable.Literal, error) {
@@ -87,6 +87,10 @@ func (b *URLBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*url.URL
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *URLBuilder) element() (variable.TypedSchema[*url.URL], *variable.TypedSpecBuilder[*url.URL]) {
+	return b.schema, &b.builder
+}
+
 type urlMarshaler struct{}
 
 func (urlMarshaler) Marshal(v *url.URL) (variable.Literal, error) {
@@ -0,0 +1,311 @@
+package ferrite
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// UUID configures an environment variable as a universally unique identifier.
+//
+// UUIDs may be specified in canonical form, such as
+// "f47ac10b-58cc-4372-a567-0e02b2c3d479", enclosed in braces, or as a URN
+// with the "urn:uuid:" prefix. The value is normalized to the lowercase
+// canonical form.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func UUID(name, desc string) *UUIDBuilder[UUIDValue] {
+	return UUIDAs[UUIDValue](name, desc)
+}
+
+// UUIDAs configures an environment variable as a universally unique
+// identifier, represented as type T.
+//
+// It can be used to produce values of UUID types from other packages that are
+// based on [16]byte.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func UUIDAs[T ~[16]byte](name, desc string) *UUIDBuilder[T] {
+	b := &UUIDBuilder[T]{
+		schema: variable.TypedOther[T]{
+			Marshaler: uuidMarshaler[T]{},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// UUIDBuilder builds a specification for a UUID variable.
+type UUIDBuilder[T ~[16]byte] struct {
+	schema    variable.TypedOther[T]
+	builder   variable.TypedSpecBuilder[T]
+	versions  []int
+	completed bool
+}
+
+var _ isBuilderOf[UUIDValue, *UUIDBuilder[UUIDValue]]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. It panics if
+// v is not a valid UUID.
+func (b *UUIDBuilder[T]) WithDefault(v string) *UUIDBuilder[T] {
+	id, err := parseUUID(v)
+	if err != nil {
+		panic(fmt.Sprintf("invalid UUID (%s): %s", v, err))
+	}
+
+	b.builder.Default(T(id))
+	return b
+}
+
+// WithVersion restricts the variable to UUIDs of specific versions, such as
+// version 4 (random) or version 7 (time-ordered) UUIDs.
+//
+// UUIDs must also use the variant described by RFC 9562.
+func (b *UUIDBuilder[T]) WithVersion(v int, additional ...int) *UUIDBuilder[T] {
+	for _, v := range append([]int{v}, additional...) {
+		if v < 1 || v > 8 {
+			panic(fmt.Sprintf("UUID version must be between 1 and 8, got %d", v))
+		}
+		b.versions = append(b.versions, v)
+	}
+
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *UUIDBuilder[T]) Required(options ...RequiredOption) Required[T] {
+	b.complete()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *UUIDBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
+	b.complete()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *UUIDBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
+	b.complete()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *UUIDBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	b.complete()
+	return b.schema, &b.builder
+}
+
+// complete adds the constraints, examples and documentation that depend on the
+// builder's options.
+//
+// It has no effect if it has already been called.
+func (b *UUIDBuilder[T]) complete() {
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	versions := b.versions
+	if len(versions) == 0 {
+		versions = []int{4, 7}
+
+		b.schema.Requirement = "**MUST** be a valid UUID"
+	} else {
+		var names []string
+		for _, v := range versions {
+			names = append(names, strconv.Itoa(v))
+		}
+		list := inlineList(names)
+
+		b.builder.BuiltInConstraint(
+			fmt.Sprintf("**MUST** be a version %s UUID", list),
+			func(v T) variable.ConstraintError {
+				id := UUIDValue(v)
+
+				if !id.isRFC9562Variant() {
+					return fmt.Errorf("unexpected variant, expected a version %s UUID", list)
+				}
+
+				for _, n := range versions {
+					if id.Version() == n {
+						return nil
+					}
+				}
+
+				return fmt.Errorf("unexpected version (%d), expected version %s", id.Version(), list)
+			},
+		)
+	}
+
+	for _, v := range versions {
+		b.builder.NonNormativeExample(
+			T(uuidExample(v)),
+			uuidVersionDescription(v),
+		)
+	}
+
+	b.builder.Documentation().
+		Summary("UUID syntax").
+		Paragraph(
+			"UUIDs are specified in their canonical form,",
+			"which consists of 32 hexadecimal digits separated into groups of 8, 4, 4, 4 and 12 digits by hyphens.",
+			"The UUID may optionally be enclosed in braces, or prefixed with `urn:uuid:`.",
+		).
+		Format().
+		Paragraph(
+			"Hexadecimal digits may be uppercase or lowercase.",
+			"The value is normalized to the lowercase canonical form.",
+		).
+		Format().
+		Done()
+}
+
+// UUIDValue is a universally unique identifier.
+type UUIDValue [16]byte
+
+// Version returns the UUID's version number.
+func (v UUIDValue) Version() int {
+	return int(v[6] >> 4)
+}
+
+// String returns the lowercase canonical representation of the UUID.
+func (v UUIDValue) String() string {
+	var buf [36]byte
+
+	hex.Encode(buf[0:8], v[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], v[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], v[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], v[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], v[10:16])
+
+	return string(buf[:])
+}
+
+// isRFC9562Variant returns true if the UUID uses the variant described by
+// RFC 9562, which is the variant used by all standard UUID versions.
+func (v UUIDValue) isRFC9562Variant() bool {
+	return v[8]&0xc0 == 0x80
+}
+
+// uuidExample returns an example UUID of the given version.
+func uuidExample(version int) UUIDValue {
+	id, err := parseUUID("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	if err != nil {
+		panic(err)
+	}
+
+	id[6] = id[6]&0x0f | byte(version<<4)
+
+	return id
+}
+
+// uuidVersionDescription returns a description of a UUID version.
+func uuidVersionDescription(version int) string {
+	switch version {
+	case 1:
+		return "a version 1 (time-based) UUID"
+	case 3:
+		return "a version 3 (name-based, MD5) UUID"
+	case 4:
+		return "a version 4 (random) UUID"
+	case 5:
+		return "a version 5 (name-based, SHA-1) UUID"
+	case 6:
+		return "a version 6 (reordered time-based) UUID"
+	case 7:
+		return "a version 7 (time-ordered) UUID"
+	}
+
+	return fmt.Sprintf("a version %d UUID", version)
+}
+
+// parseUUID parses a UUID in canonical, braced or URN form.
+func parseUUID(s string) (UUIDValue, error) {
+	var id UUIDValue
+
+	offset := 0
+	canonical := s
+
+	switch {
+	case len(s) >= 9 && strings.EqualFold(s[:9], "urn:uuid:"):
+		offset = 9
+		canonical = s[9:]
+	case strings.HasPrefix(s, "{"):
+		if !strings.HasSuffix(s, "}") {
+			return id, fmt.Errorf("expected a closing brace at offset %d", len(s))
+		}
+		offset = 1
+		canonical = s[1 : len(s)-1]
+	}
+
+	if len(canonical) != 36 {
+		return id, fmt.Errorf(
+			"expected 32 hexadecimal digits with hyphens in the canonical positions (8-4-4-4-12), got %d characters",
+			len(canonical),
+		)
+	}
+
+	n := 0
+	for i := 0; i < len(canonical); i++ {
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if canonical[i] != '-' {
+				return id, fmt.Errorf("expected a hyphen at offset %d", offset+i)
+			}
+			continue
+		}
+
+		d, ok := fromHexDigit(canonical[i])
+		if !ok {
+			return id, fmt.Errorf("unexpected character (%c) at offset %d, expected a hexadecimal digit", canonical[i], offset+i)
+		}
+
+		id[n/2] |= d << (4 * (1 - n%2))
+		n++
+	}
+
+	return id, nil
+}
+
+// fromHexDigit returns the value of the hexadecimal digit c.
+func fromHexDigit(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+
+	return 0, false
+}
+
+type uuidMarshaler[T ~[16]byte] struct{}
+
+func (uuidMarshaler[T]) Marshal(v T) (variable.Literal, error) {
+	return variable.Literal{
+		String: UUIDValue(v).String(),
+	}, nil
+}
+
+func (uuidMarshaler[T]) Unmarshal(v variable.Literal) (T, error) {
+	id, err := parseUUID(v.String)
+	return T(id), err
+}
@@ -0,0 +1,322 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type userDefinedUUID [16]byte
+
+var _ = Describe("type UUIDBuilder", func() {
+	var builder *UUIDBuilder[UUIDValue]
+
+	BeforeEach(func() {
+		builder = UUID("FERRITE_UUID", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			UUID("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			UUID("FERRITE_UUID", "").Optional()
+		}).To(PanicWith("specification for FERRITE_UUID is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		Expect(func() {
+			builder.WithDefault("<invalid>")
+		}).To(PanicWith("invalid UUID (<invalid>): expected 32 hexadecimal digits with hyphens in the canonical positions (8-4-4-4-12), got 9 characters"))
+	})
+
+	It("panics if the default value does not have a permitted version", func() {
+		Expect(func() {
+			builder.
+				WithVersion(7).
+				WithDefault("f47ac10b-58cc-4372-a567-0e02b2c3d479").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_UUID is invalid: default value: unexpected version (4), expected version 7"))
+	})
+
+	It("panics if the version is out of range", func() {
+		Expect(func() {
+			builder.WithVersion(9)
+		}).To(PanicWith("UUID version must be between 1 and 8, got 9"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is a valid UUID", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the UUID",
+					func(value string) {
+						os.Setenv("FERRITE_UUID", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(UUIDValue{
+							0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72,
+							0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79,
+						}))
+						Expect(v.String()).To(Equal("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+						Expect(v.Version()).To(Equal(4))
+					},
+					Entry("canonical", "f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+					Entry("uppercase", "F47AC10B-58CC-4372-A567-0E02B2C3D479"),
+					Entry("braced", "{f47ac10b-58cc-4372-a567-0e02b2c3d479}"),
+					Entry("URN", "urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+					Entry("uppercase URN", "URN:UUID:F47AC10B-58CC-4372-A567-0E02B2C3D479"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_UUID", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"wrong length",
+						"f47ac10b58cc4372a5670e02b2c3d479",
+						`value of FERRITE_UUID (f47ac10b58cc4372a5670e02b2c3d479) is invalid: expected 32 hexadecimal digits with hyphens in the canonical positions (8-4-4-4-12), got 32 characters`,
+					),
+					Entry(
+						"misplaced hyphen",
+						"f47ac10b5-8cc-4372-a567-0e02b2c3d479",
+						`value of FERRITE_UUID (f47ac10b5-8cc-4372-a567-0e02b2c3d479) is invalid: expected a hyphen at offset 8`,
+					),
+					Entry(
+						"invalid digit",
+						"urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d47z",
+						`value of FERRITE_UUID (urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d47z) is invalid: unexpected character (z) at offset 44, expected a hexadecimal digit`,
+					),
+					Entry(
+						"missing closing brace",
+						"{f47ac10b-58cc-4372-a567-0e02b2c3d479",
+						`value of FERRITE_UUID ('{f47ac10b-58cc-4372-a567-0e02b2c3d479') is invalid: expected a closing brace at offset 37`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("{F47AC10B-58CC-4372-A567-0E02B2C3D479}").
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("f47ac10b-58cc-4372-a567-0e02b2c3d479"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_UUID is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the version is restricted", func() {
+		DescribeTable(
+			"it accepts UUIDs with a permitted version",
+			func(value string) {
+				os.Setenv("FERRITE_UUID", value)
+
+				v := builder.
+					WithVersion(4, 7).
+					Required().
+					Value()
+
+				Expect(v.String()).To(Equal(value))
+			},
+			Entry("version 4", "f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+			Entry("version 7", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"),
+		)
+
+		DescribeTable(
+			"it panics if the UUID does not have a permitted version",
+			func(value, expect string) {
+				os.Setenv("FERRITE_UUID", value)
+
+				Expect(func() {
+					builder.
+						WithVersion(4, 7).
+						Required().
+						Value()
+				}).To(PanicWith(expect))
+			},
+			Entry(
+				"version 1",
+				"c232ab00-9414-11ec-b3c8-9f6bdeced846",
+				`value of FERRITE_UUID (c232ab00-9414-11ec-b3c8-9f6bdeced846) is invalid: unexpected version (1), expected version 4 or 7`,
+			),
+			Entry(
+				"nil UUID",
+				"00000000-0000-0000-0000-000000000000",
+				`value of FERRITE_UUID (00000000-0000-0000-0000-000000000000) is invalid: unexpected variant, expected a version 4 or 7 UUID`,
+			),
+		)
+	})
+})
+
+var _ = Describe("func UUIDAs()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("returns a value of the user-defined type", func() {
+		os.Setenv("FERRITE_UUID", "f47ac10b-58cc-4372-a567-0e02b2c3d479")
+
+		v := UUIDAs[userDefinedUUID]("FERRITE_UUID", "<desc>").
+			Required().
+			Value()
+
+		Expect(v).To(Equal(userDefinedUUID{
+			0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72,
+			0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79,
+		}))
+	})
+})
+
+func ExampleUUID_required() {
+	defer example()()
+
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		Required()
+
+	os.Setenv("FERRITE_UUID", "f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is f47ac10b-58cc-4372-a567-0e02b2c3d479
+}
+
+func ExampleUUID_default() {
+	defer example()()
+
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		WithDefault("f47ac10b-58cc-4372-a567-0e02b2c3d479").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is f47ac10b-58cc-4372-a567-0e02b2c3d479
+}
+
+func ExampleUUID_optional() {
+	defer example()()
+
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleUUID_version() {
+	defer example()()
+
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		WithVersion(7).
+		Required()
+
+	os.Setenv("FERRITE_UUID", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+	ferrite.Init()
+
+	fmt.Println("version is", v.Value().Version())
+
+	// Output:
+	// version is 7
+}
+
+func ExampleUUID_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_UUID", "urn:uuid:F47AC10B-58CC-4372-A567-0E02B2C3D479")
+	v := ferrite.
+		UUID("FERRITE_UUID", "example UUID variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_UUID  example UUID variable  [ <string> ]  ⚠ deprecated variable set to urn:uuid:F47AC10B-58CC-4372-A567-0E02B2C3D479, equivalent to f47ac10b-58cc-4372-a567-0e02b2c3d479
+	//
+	// value is f47ac10b-58cc-4372-a567-0e02b2c3d479
+}
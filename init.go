@@ -28,6 +28,9 @@ import (
 //
 // "export/dotenv" mode: This mode renders environment variables to `STDOUT` in
 // a format suitable for use as a `.env` file.
+//
+// Once the mode has run, Init() populates the fields of any structs bound to
+// the registry's environment variables using Bind().
 func Init(options ...InitOption) {
 	cfg := initConfig{
 		mode.DefaultConfig,
@@ -48,6 +51,8 @@ func Init(options ...InitOption) {
 		fmt.Fprintf(cfg.ModeConfig.Err, "unrecognized FERRITE_MODE (%s)\n", m)
 		cfg.ModeConfig.Exit(1)
 	}
+
+	populateBindings(cfg.ModeConfig.Registry)
 }
 
 // An InitOption changes the behavior of the Init() function.
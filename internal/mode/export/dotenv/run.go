@@ -21,9 +21,9 @@ func Run(cfg mode.Config) {
 		must.Fprintf(cfg.Out, "# %s (", s.Description())
 
 		if def, ok := s.Default(); ok {
-			x := def.Quote()
-			if s.IsSensitive() {
-				x = strings.Repeat("*", len(x))
+			x := strings.Repeat("*", len(def.Quote()))
+			if r, ok := s.Redact(def); ok {
+				x = r.Quote()
 			}
 			must.Fprintf(cfg.Out, "default: %s", x)
 		} else if s.IsDeprecated() {
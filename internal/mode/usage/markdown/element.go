@@ -0,0 +1,140 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// listRequirement returns the requirement text for a value that uses the
+// "list" schema type.
+func listRequirement(s variable.List) string {
+	req := fmt.Sprintf("**MUST** be a `%s`-separated list", s.Separator())
+
+	min, hasMin := s.MinElements()
+	max, hasMax := s.MaxElements()
+
+	if hasMin && hasMax {
+		if min == max {
+			req += fmt.Sprintf(" of exactly %d elements", min)
+		} else {
+			req += fmt.Sprintf(" of between %d and %d elements", min, max)
+		}
+	} else if hasMin {
+		req += fmt.Sprintf(" of at least %d elements", min)
+	} else if hasMax {
+		req += fmt.Sprintf(" of no more than %d elements", max)
+	}
+
+	if s.IsUnique() {
+		req += " with no duplicates"
+	}
+
+	if elem := elementRequirement(s.Element(), s.ElementConstraints()); elem != "" {
+		req += ", where each element " + elem
+	}
+
+	return req
+}
+
+// mapRequirement returns the requirement text for a value that uses the "map"
+// schema type.
+func mapRequirement(s variable.Map) string {
+	req := fmt.Sprintf(
+		"**MUST** be a `%s`-separated list of `key%svalue` pairs",
+		s.PairSeparator(),
+		s.KeyValueSeparator(),
+	)
+
+	quote := func(lit variable.Literal) string {
+		return fmt.Sprintf("`%s`", lit.String)
+	}
+
+	if keys := s.RequiredKeys(); len(keys) == 1 {
+		req += fmt.Sprintf(" that includes the %s key", quote(keys[0]))
+	} else if len(keys) > 1 {
+		req += fmt.Sprintf(" that includes the %s keys", andList(keys, quote))
+	}
+
+	var clauses []string
+
+	if keys := s.AllowedKeys(); len(keys) == 1 {
+		clauses = append(clauses, "each key **MUST** be "+quote(keys[0]))
+	} else if len(keys) > 1 {
+		clauses = append(clauses, "each key **MUST** be one of "+orList(keys, quote))
+	} else if key := elementRequirement(s.Key(), s.KeyConstraints()); key != "" {
+		clauses = append(clauses, "each key "+key)
+	}
+
+	if value := elementRequirement(s.Value(), s.ValueConstraints()); value != "" {
+		clauses = append(clauses, "each value "+value)
+	}
+
+	if len(clauses) != 0 {
+		req += ", where " + strings.Join(clauses, " and ")
+	}
+
+	return req
+}
+
+// elementRequirement returns the requirement text for the elements of a
+// compound value, such as a list or map.
+func elementRequirement(s variable.Schema, constraints []variable.Constraint) string {
+	r := &elementRenderer{
+		Constraints: constraints,
+	}
+	s.AcceptVisitor(r)
+	return r.Requirement
+}
+
+// elementRenderer is a schema visitor that builds the requirement text for the
+// elements of a compound value.
+type elementRenderer struct {
+	Constraints []variable.Constraint
+	Requirement string
+}
+
+func (r *elementRenderer) VisitNumeric(s variable.Numeric) {
+	r.Requirement = numericRequirement(s)
+}
+
+func (r *elementRenderer) VisitSet(s variable.Set) {
+	lits := s.Literals()
+
+	if len(lits) == 2 {
+		r.Requirement = fmt.Sprintf(
+			"**MUST** be either `%s` or `%s`",
+			lits[0].String,
+			lits[1].String,
+		)
+		return
+	}
+
+	r.Requirement = "**MUST** be one of " + orList(
+		lits,
+		func(lit variable.Literal) string {
+			return fmt.Sprintf("`%s`", lit.String)
+		},
+	)
+}
+
+func (r *elementRenderer) VisitString(s variable.String) {
+	r.Requirement = schemaRequirement(s, r.Constraints)
+}
+
+func (r *elementRenderer) VisitList(s variable.List) {
+	r.Requirement = listRequirement(s)
+}
+
+func (r *elementRenderer) VisitMap(s variable.Map) {
+	r.Requirement = mapRequirement(s)
+}
+
+func (r *elementRenderer) VisitBinary(s variable.Binary) {
+	r.Requirement = binaryRequirement(s)
+}
+
+func (r *elementRenderer) VisitOther(s variable.Other) {
+	r.Requirement = schemaRequirement(s, r.Constraints)
+}
@@ -19,19 +19,39 @@ func (r *renderer) hasNonNormativeExamples() bool {
 	return false
 }
 
+// canRenderExamples returns true if there are examples to render, and they can
+// be rendered without revealing sensitive information.
+func (r *specRenderer) canRenderExamples() bool {
+	examples := r.spec.Examples()
+	if len(examples) == 0 {
+		return false
+	}
+
+	for _, eg := range examples {
+		if _, ok := r.spec.Redact(eg.Canonical); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (r *specRenderer) renderExamples() {
 	r.ren.gap()
 	r.ren.line("```bash")
 
 	width := 0
 	for _, eg := range r.spec.Examples() {
-		w := runewidth.StringWidth(eg.Canonical.Quote())
+		lit, _ := r.spec.Redact(eg.Canonical)
+		w := runewidth.StringWidth(lit.Quote())
 		if w > width {
 			width = w
 		}
 	}
 
 	for _, eg := range r.spec.Examples() {
+		lit, _ := r.spec.Redact(eg.Canonical)
+
 		comment := ""
 		if variable.IsDefault(r.spec, eg.Canonical) {
 			comment = "(default)"
@@ -50,14 +70,14 @@ func (r *specRenderer) renderExamples() {
 			r.ren.line(
 				"export %s=%s",
 				r.spec.Name(),
-				eg.Canonical.Quote(),
+				lit.Quote(),
 			)
 		} else {
 			r.ren.line(
 				"export %s=%-*s # %s",
 				r.spec.Name(),
 				width,
-				eg.Canonical.Quote(),
+				lit.Quote(),
 				comment,
 			)
 		}
@@ -2,6 +2,7 @@ package markdown
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 
 	"github.com/dogmatiq/ferrite/variable"
@@ -28,7 +29,9 @@ func (r *specRenderer) Render() {
 			"⚠️ This variable is **sensitive**;",
 			"its value may contain private information.",
 		)()
-	} else {
+	}
+
+	if r.canRenderExamples() {
 		r.renderExamples()
 	}
 
@@ -39,25 +42,40 @@ func (r *specRenderer) Render() {
 // VisitNumeric renders the primary requirement for spec that uses the "numeric"
 // schema type.
 func (r *specRenderer) VisitNumeric(s variable.Numeric) {
+	r.renderPrimaryRequirement("%s", numericRequirement(s))
+}
+
+// numericRequirement returns the requirement text for a value that uses the
+// "numeric" schema type.
+func numericRequirement(s variable.Numeric) string {
 	min, hasMin := s.Min()
 	max, hasMax := s.Max()
 
 	if hasMin && hasMax {
-		r.renderPrimaryRequirement("**MUST** be between `%s` and `%s`", min.String, max.String)
+		return fmt.Sprintf("**MUST** be between `%s` and `%s`", min.String, max.String)
 	} else if hasMin {
-		r.renderPrimaryRequirement("**MUST** be `%s` or greater", min.String)
+		return fmt.Sprintf("**MUST** be `%s` or greater", min.String)
 	} else if hasMax {
-		r.renderPrimaryRequirement("**MUST** be `%s` or less", max.String)
-	} else {
-		switch s.Type().Kind() {
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			r.renderPrimaryRequirement("**MUST** be a whole number")
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			r.renderPrimaryRequirement("**MUST** be a non-negative whole number")
-		case reflect.Float32, reflect.Float64:
-			r.renderPrimaryRequirement("**MUST** be a number with an **OPTIONAL** fractional part")
-		}
+		return fmt.Sprintf("**MUST** be `%s` or less", max.String)
+	}
+
+	switch s.Type() {
+	case reflect.TypeOf(&big.Int{}):
+		return "**MUST** be a whole number"
+	case reflect.TypeOf(&big.Rat{}):
+		return "**MUST** be a number with an **OPTIONAL** fractional part"
 	}
+
+	switch s.Type().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "**MUST** be a whole number"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "**MUST** be a non-negative whole number"
+	case reflect.Float32, reflect.Float64:
+		return "**MUST** be a number with an **OPTIONAL** fractional part"
+	}
+
+	return ""
 }
 
 // VisitSet renders the primary requirement for spec that uses the "set" schema
@@ -76,11 +94,38 @@ func (r *specRenderer) VisitSet(s variable.Set) {
 
 // VisitString renders the primary requirement for a spec that uses the "string"
 // schema type.
-func (r *specRenderer) VisitString(variable.String) {
-	// Find the best constraint to use as the "primary" requirement, favoring
-	// non-user-defined constraints.
+func (r *specRenderer) VisitString(s variable.String) {
+	r.renderPrimaryRequirement("%s", schemaRequirement(s, r.spec.Constraints()))
+}
+
+// schemaRequirement returns the requirement text for a value that uses a
+// schema without any inherent requirements, such as the "string" or "other"
+// schema types.
+//
+// Built-in constraints are favored over the schema's own description, as they
+// are typically more specific. The schema's description is favored over
+// user-defined constraints.
+func schemaRequirement(s variable.Schema, constraints []variable.Constraint) string {
+	for _, c := range constraints {
+		if !c.IsUserDefined() {
+			return c.Description()
+		}
+	}
+
+	if d, ok := s.(variable.RequirementDescriber); ok {
+		if req := d.RequirementDescription(); req != "" {
+			return req
+		}
+	}
+
+	return constraintRequirement(constraints)
+}
+
+// constraintRequirement returns the description of the best constraint to use
+// as the "primary" requirement, favoring non-user-defined constraints.
+func constraintRequirement(constraints []variable.Constraint) string {
 	var con variable.Constraint
-	for _, c := range r.spec.Constraints() {
+	for _, c := range constraints {
 		if !c.IsUserDefined() {
 			con = c
 			break
@@ -90,22 +135,57 @@ func (r *specRenderer) VisitString(variable.String) {
 	}
 
 	if con == nil {
-		r.renderPrimaryRequirement("")
-	} else {
-		r.renderPrimaryRequirement(con.Description())
+		return ""
 	}
+
+	return con.Description()
 }
 
-// VisitOther render the primary requirement for a spec that uses the "other"
+// VisitList renders the primary requirement for a spec that uses the "list"
 // schema type.
-func (r *specRenderer) VisitOther(s variable.Other) {
-	con := ""
-	for _, c := range r.spec.Constraints() {
-		con = c.Description()
-		break
+func (r *specRenderer) VisitList(s variable.List) {
+	r.renderPrimaryRequirement("%s", listRequirement(s))
+}
+
+// VisitMap renders the primary requirement for a spec that uses the "map"
+// schema type.
+func (r *specRenderer) VisitMap(s variable.Map) {
+	r.renderPrimaryRequirement("%s", mapRequirement(s))
+}
+
+// VisitBinary renders the primary requirement for a spec that uses the
+// "binary" schema type.
+func (r *specRenderer) VisitBinary(s variable.Binary) {
+	r.renderPrimaryRequirement("%s", binaryRequirement(s))
+}
+
+// binaryRequirement returns the requirement text for a value that uses the
+// "binary" schema type.
+func binaryRequirement(s variable.Binary) string {
+	req := fmt.Sprintf("**MUST** be encoded as %s", s.Encoding().Name())
+
+	min, hasMin := s.MinLength()
+	max, hasMax := s.MaxLength()
+
+	if hasMin && hasMax {
+		if min == max {
+			req += fmt.Sprintf(" and decode to exactly %d bytes", min)
+		} else {
+			req += fmt.Sprintf(" and decode to between %d and %d bytes", min, max)
+		}
+	} else if hasMin {
+		req += fmt.Sprintf(" and decode to at least %d bytes", min)
+	} else if hasMax {
+		req += fmt.Sprintf(" and decode to no more than %d bytes", max)
 	}
 
-	r.renderPrimaryRequirement(con)
+	return req
+}
+
+// VisitOther render the primary requirement for a spec that uses the "other"
+// schema type.
+func (r *specRenderer) VisitOther(s variable.Other) {
+	r.renderPrimaryRequirement("%s", schemaRequirement(s, r.spec.Constraints()))
 }
 
 // renderPrimaryRequirement renders information about the most important
@@ -231,7 +311,8 @@ func (r *specRenderer) renderDefaultValueFragment() (string, bool) {
 		return "", false
 	}
 
-	if r.spec.IsSensitive() {
+	def, ok = r.spec.Redact(def)
+	if !ok {
 		return "a default value", true
 	}
 
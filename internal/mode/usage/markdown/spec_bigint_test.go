@@ -0,0 +1,78 @@
+package markdown_test
+
+import (
+	"math/big"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"bigint spec",
+	tableTest(
+		"spec/bigint",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				BigInt("LEDGER_MAX_BALANCE", "the largest permitted account balance, in cents").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				BigInt("LEDGER_MAX_BALANCE", "the largest permitted account balance, in cents").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				BigInt("LEDGER_MAX_BALANCE", "the largest permitted account balance, in cents").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				BigInt("LEDGER_MAX_BALANCE", "the largest permitted account balance, in cents").
+				WithDefault(big.NewInt(1_000_000_000_000)).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				BigInt("LEDGER_MAX_BALANCE", "the largest permitted account balance, in cents").
+				WithDefault(big.NewInt(1_000_000_000_000)).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with limits",
+		"with-limits.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				BigInt("LEDGER_MAX_BALANCE", "the largest permitted account balance, in cents").
+				WithMinimum(big.NewInt(0)).
+				WithMaximum(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,88 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"bytes spec",
+	tableTest(
+		"spec/bytes",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Bytes("SIGNING_KEY", "the key used to sign session tokens").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Bytes("SIGNING_KEY", "the key used to sign session tokens").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Bytes("SIGNING_KEY", "the key used to sign session tokens").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Bytes("SIGNING_KEY", "the key used to sign session tokens").
+				WithDefault([]byte("<default>")).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Bytes("SIGNING_KEY", "the key used to sign session tokens").
+				WithDefault([]byte("<default>")).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with hex encoding and exact length",
+		"with-length.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Bytes("SIGNING_KEY", "the key used to sign session tokens").
+				WithEncoding(ferrite.HexEncoding).
+				WithLength(32).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"non-sensitive",
+		"non-sensitive.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Bytes("SIGNING_KEY", "the key used to sign session tokens").
+				WithEncoding(ferrite.RawBase64URLEncoding).
+				WithMinimumLength(16).
+				WithNonSensitiveContent().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,88 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"byte size spec",
+	tableTest(
+		"spec/bytesize",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				WithDefault(64 << 20).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				WithDefault(64 << 20).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with limits",
+		"with-limits.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				WithMinimum(1 << 20).
+				WithMaximum(1 << 30).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with canonical unit",
+		"with-canonical-unit.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				WithCanonicalUnit("MiB").
+				WithMinimum(512 << 10).
+				WithDefault(64 << 20).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
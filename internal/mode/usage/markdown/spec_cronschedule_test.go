@@ -0,0 +1,65 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"cron schedule spec",
+	tableTest(
+		"spec/cronschedule",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				CronSchedule("CLEANUP_SCHEDULE", "the schedule on which expired records are removed").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				CronSchedule("CLEANUP_SCHEDULE", "the schedule on which expired records are removed").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				CronSchedule("CLEANUP_SCHEDULE", "the schedule on which expired records are removed").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				CronSchedule("CLEANUP_SCHEDULE", "the schedule on which expired records are removed").
+				WithDefault("0 3 * * *").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				CronSchedule("CLEANUP_SCHEDULE", "the schedule on which expired records are removed").
+				WithDefault("0 3 * * *").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
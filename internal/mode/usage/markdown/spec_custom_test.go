@@ -0,0 +1,87 @@
+package markdown_test
+
+import (
+	"net/netip"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"custom spec",
+	tableTest(
+		"spec/custom",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Custom[netip.Addr]("DNS_SERVER", "the address of the DNS server").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Custom[netip.Addr]("DNS_SERVER", "the address of the DNS server").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Custom[netip.Addr]("DNS_SERVER", "the address of the DNS server").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Custom[netip.Addr]("DNS_SERVER", "the address of the DNS server").
+				WithDefault(netip.MustParseAddr("1.1.1.1")).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Custom[netip.Addr]("DNS_SERVER", "the address of the DNS server").
+				WithDefault(netip.MustParseAddr("1.1.1.1")).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with documentation and examples",
+		"with-documentation.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Custom[netip.Addr]("DNS_SERVER", "the address of the DNS server").
+				WithConstraint(
+					"**MUST** be a unicast IP address",
+					func(v netip.Addr) bool { return v.IsGlobalUnicast() || v.IsLoopback() },
+				).
+				WithDocumentation(
+					"IP address syntax",
+					"IPv4 addresses are specified in dotted-decimal notation, such as `192.0.2.1`.",
+					"IPv6 addresses, such as `2001:db8::1`, are specified in colon-hexadecimal notation. Zones are not permitted.",
+				).
+				WithExample(netip.MustParseAddr("192.0.2.1"), "an IPv4 address").
+				WithExample(netip.MustParseAddr("2001:db8::1"), "an IPv6 address").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,97 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"decimal spec",
+	tableTest(
+		"spec/decimal",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Decimal("ORDER_LIMIT", "the maximum value of a single order").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Decimal("ORDER_LIMIT", "the maximum value of a single order").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Decimal("ORDER_LIMIT", "the maximum value of a single order").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Decimal("ORDER_LIMIT", "the maximum value of a single order").
+				WithDefault("250.5").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Decimal("ORDER_LIMIT", "the maximum value of a single order").
+				WithDefault("250.5").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with limits",
+		"with-limits.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Decimal("ORDER_LIMIT", "the maximum value of a single order").
+				WithMinimum("0.01").
+				WithMaximum("10000").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with scale",
+		"with-scale.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Decimal("ORDER_LIMIT", "the maximum value of a single order").
+				WithScale(2).
+				WithDefault("250.5").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with zero scale",
+		"with-zero-scale.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Decimal("ORDER_LIMIT", "the maximum value of a single order").
+				WithScale(0).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,89 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"directory spec",
+	tableTest(
+		"spec/directory",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Directory("DATA_DIR", "the directory in which application data is stored").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Directory("DATA_DIR", "the directory in which application data is stored").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Directory("DATA_DIR", "the directory in which application data is stored").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Directory("DATA_DIR", "the directory in which application data is stored").
+				WithDefault("/var/lib/app").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Directory("DATA_DIR", "the directory in which application data is stored").
+				WithDefault("/var/lib/app").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with file system checks",
+		"with-checks.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Directory("DATA_DIR", "the directory in which application data is stored").
+				WithMustExist().
+				WithReadAccess().
+				WithWriteAccess().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"created if missing",
+		"with-create.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Directory("DATA_DIR", "the directory in which application data is stored").
+				WithCreateIfMissing().
+				WithWriteAccess().
+				WithDefault("/var/lib/app").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
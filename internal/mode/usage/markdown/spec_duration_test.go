@@ -1,6 +1,7 @@
 package markdown_test
 
 import (
+	"math"
 	"time"
 
 	"github.com/dogmatiq/ferrite"
@@ -75,4 +76,16 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"with extended syntax",
+		"with-extended-syntax.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Duration("GRPC_TIMEOUT", "gRPC request timeout").
+				WithDayAndWeekUnits().
+				WithISO8601Syntax().
+				WithNever(math.MaxInt64).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 )
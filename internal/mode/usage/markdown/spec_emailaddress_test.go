@@ -0,0 +1,76 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"email address spec",
+	tableTest(
+		"spec/emailaddress",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				EmailAddress("ALERT_SENDER", "the address that alert emails are sent from").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				EmailAddress("ALERT_SENDER", "the address that alert emails are sent from").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				EmailAddress("ALERT_SENDER", "the address that alert emails are sent from").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				EmailAddress("ALERT_SENDER", "the address that alert emails are sent from").
+				WithDefault("Alerts <alerts@example.org>").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				EmailAddress("ALERT_SENDER", "the address that alert emails are sent from").
+				WithDefault("Alerts <alerts@example.org>").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with restrictions",
+		"with-restrictions.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				EmailAddress("ALERT_SENDER", "the address that alert emails are sent from").
+				WithoutDisplayName().
+				WithAllowedDomains("example.org", "example.net").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
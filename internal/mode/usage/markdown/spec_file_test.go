@@ -62,4 +62,15 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"with file system checks",
+		"with-checks.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				File("PRIVATE_KEY", "path to the private key file").
+				WithMustExist().
+				WithReadAccess().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 )
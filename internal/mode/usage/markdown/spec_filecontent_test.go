@@ -0,0 +1,76 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"file content spec",
+	tableTest(
+		"spec/filecontent",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				FileContent("TLS_CERT", "the server's TLS certificate").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				FileContent("TLS_CERT", "the server's TLS certificate").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				FileContent("TLS_CERT", "the server's TLS certificate").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				FileContent("TLS_CERT", "the server's TLS certificate").
+				WithDefault("/etc/ssl/cert.pem").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				FileContent("TLS_CERT", "the server's TLS certificate").
+				WithDefault("/etc/ssl/cert.pem").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with options",
+		"with-options.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				FileContent("TLS_CERT", "the server's TLS certificate").
+				WithMaximumSize(64 * 1024).
+				WithTrimTrailingNewline().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
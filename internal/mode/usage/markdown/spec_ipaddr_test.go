@@ -0,0 +1,77 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"ip address spec",
+	tableTest(
+		"spec/ipaddr",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDRESS", "the address to bind the server to").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDRESS", "the address to bind the server to").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDRESS", "the address to bind the server to").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDRESS", "the address to bind the server to").
+				WithDefault("0.0.0.0").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDRESS", "the address to bind the server to").
+				WithDefault("0.0.0.0").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with restrictions",
+		"with-restrictions.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDRESS", "the address to bind the server to").
+				WithIPv4Only().
+				WithoutLoopback().
+				WithoutUnspecified().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,81 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+type retryPolicy struct {
+	Attempts int    `json:"attempts"`
+	Backoff  string `json:"backoff"`
+}
+
+var _ = DescribeTable(
+	"json spec",
+	tableTest(
+		"spec/json",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				JSON[retryPolicy]("RETRY_POLICY", "the policy used to retry failed requests").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				JSON[retryPolicy]("RETRY_POLICY", "the policy used to retry failed requests").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				JSON[retryPolicy]("RETRY_POLICY", "the policy used to retry failed requests").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				JSON[retryPolicy]("RETRY_POLICY", "the policy used to retry failed requests").
+				WithDefault(retryPolicy{Attempts: 5, Backoff: "500ms"}).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				JSON[retryPolicy]("RETRY_POLICY", "the policy used to retry failed requests").
+				WithDefault(retryPolicy{Attempts: 5, Backoff: "500ms"}).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"sensitive",
+		"sensitive.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				JSON[retryPolicy]("RETRY_POLICY", "the policy used to retry failed requests").
+				WithDefault(retryPolicy{Attempts: 5, Backoff: "500ms"}).
+				WithSensitiveContent().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,101 @@
+package markdown_test
+
+import (
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"list spec",
+	tableTest(
+		"spec/list",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				List("ALLOWED_ORIGINS", "origins permitted to make cross-origin requests").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				List("ALLOWED_ORIGINS", "origins permitted to make cross-origin requests").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				List("ALLOWED_ORIGINS", "origins permitted to make cross-origin requests").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				List("ALLOWED_ORIGINS", "origins permitted to make cross-origin requests").
+				WithDefault("example.org", "example.com").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				List("ALLOWED_ORIGINS", "origins permitted to make cross-origin requests").
+				WithDefault("example.org", "example.com").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with element count limits and unique elements",
+		"with-limits.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ListOf[time.Duration](
+				"RETRY_DELAYS",
+				"delays between successive retry attempts",
+				ferrite.
+					Duration("", "").
+					WithMaximum(1*time.Minute),
+			).
+				WithSeparator(";").
+				WithMinimumElements(1).
+				WithMaximumElements(5).
+				WithUniqueElements().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with enum elements",
+		"with-enum.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				ListOf[string](
+				"LOG_CHANNELS",
+				"channels that produce log output",
+				ferrite.
+					Enum("", "").
+					WithMembers("http", "sql", "cache"),
+			).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
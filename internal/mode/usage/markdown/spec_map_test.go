@@ -0,0 +1,87 @@
+package markdown_test
+
+import (
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"map spec",
+	tableTest(
+		"spec/map",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Map("RESOURCE_TAGS", "tags applied to provisioned resources").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Map("RESOURCE_TAGS", "tags applied to provisioned resources").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Map("RESOURCE_TAGS", "tags applied to provisioned resources").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Map("RESOURCE_TAGS", "tags applied to provisioned resources").
+				WithDefault(map[string]string{"team": "platform", "env": "dev"}).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Map("RESOURCE_TAGS", "tags applied to provisioned resources").
+				WithDefault(map[string]string{"team": "platform", "env": "dev"}).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with allowed and required keys",
+		"with-keys.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				MapOf[string, time.Duration](
+				"OPERATION_TIMEOUTS",
+				"timeouts for each kind of operation",
+				ferrite.String("", ""),
+				ferrite.
+					Duration("", "").
+					WithMaximum(1*time.Minute),
+			).
+				WithPairSeparator(";").
+				WithKeyValueSeparator(":").
+				WithAllowedKeys("read", "write", "delete").
+				WithRequiredKeys("read", "write").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
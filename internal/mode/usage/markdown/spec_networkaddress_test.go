@@ -0,0 +1,75 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"network address spec",
+	tableTest(
+		"spec/networkaddress",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				NetworkAddress("REDIS_ADDR", "the address of the Redis server").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				NetworkAddress("REDIS_ADDR", "the address of the Redis server").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				NetworkAddress("REDIS_ADDR", "the address of the Redis server").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				NetworkAddress("REDIS_ADDR", "the address of the Redis server").
+				WithDefault("localhost:6379").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				NetworkAddress("REDIS_ADDR", "the address of the Redis server").
+				WithDefault("localhost:6379").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with default port",
+		"with-default-port.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				NetworkAddress("REDIS_ADDR", "the address of the Redis server").
+				WithDefaultPort("6379").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
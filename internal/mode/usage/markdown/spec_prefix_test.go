@@ -0,0 +1,76 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"prefix spec",
+	tableTest(
+		"spec/prefix",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network containing trusted reverse proxies").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network containing trusted reverse proxies").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network containing trusted reverse proxies").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network containing trusted reverse proxies").
+				WithDefault("10.0.0.0/8").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network containing trusted reverse proxies").
+				WithDefault("10.0.0.0/8").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with canonical form",
+		"with-canonical-form.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network containing trusted reverse proxies").
+				WithIPv6Only().
+				WithCanonicalForm().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
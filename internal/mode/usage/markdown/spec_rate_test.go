@@ -0,0 +1,78 @@
+package markdown_test
+
+import (
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"rate spec",
+	tableTest(
+		"spec/rate",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Rate("API_RATE_LIMIT", "maximum rate of API requests per client").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Rate("API_RATE_LIMIT", "maximum rate of API requests per client").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Rate("API_RATE_LIMIT", "maximum rate of API requests per client").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Rate("API_RATE_LIMIT", "maximum rate of API requests per client").
+				WithDefault(100, time.Second).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Rate("API_RATE_LIMIT", "maximum rate of API requests per client").
+				WithDefault(100, time.Second).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with limits",
+		"with-limits.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Rate("API_RATE_LIMIT", "maximum rate of API requests per client").
+				WithMinimumPerSecond(1).
+				WithMaximumPerSecond(1000).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
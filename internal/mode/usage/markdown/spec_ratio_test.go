@@ -0,0 +1,87 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"ratio spec",
+	tableTest(
+		"spec/ratio",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Ratio("TRACE_SAMPLE_RATE", "proportion of requests to trace").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Ratio("TRACE_SAMPLE_RATE", "proportion of requests to trace").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Ratio("TRACE_SAMPLE_RATE", "proportion of requests to trace").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Ratio("TRACE_SAMPLE_RATE", "proportion of requests to trace").
+				WithDefault(0.05).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Ratio("TRACE_SAMPLE_RATE", "proportion of requests to trace").
+				WithDefault(0.05).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with limits",
+		"with-limits.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Ratio("TRACE_SAMPLE_RATE", "proportion of requests to trace").
+				WithMinimum(0.01).
+				WithMaximum(0.5).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with canonical percentage",
+		"with-canonical-percentage.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Ratio("TRACE_SAMPLE_RATE", "proportion of requests to trace").
+				WithCanonicalPercentage().
+				WithDefault(0.075).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,75 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"regexp spec",
+	tableTest(
+		"spec/regexp",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "the pattern that request paths must match").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "the pattern that request paths must match").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "the pattern that request paths must match").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "the pattern that request paths must match").
+				WithDefault(`^/api/`).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "the pattern that request paths must match").
+				WithDefault(`^/api/`).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with full anchor",
+		"with-full-anchor.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Regexp("ROUTE_PATTERN", "the pattern that request paths must match").
+				WithFullAnchor().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
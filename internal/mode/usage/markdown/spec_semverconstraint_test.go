@@ -0,0 +1,65 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"semver constraint spec",
+	tableTest(
+		"spec/semverconstraint",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				SemVerConstraint("SUPPORTED_API", "the range of supported API versions").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				SemVerConstraint("SUPPORTED_API", "the range of supported API versions").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				SemVerConstraint("SUPPORTED_API", "the range of supported API versions").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				SemVerConstraint("SUPPORTED_API", "the range of supported API versions").
+				WithDefault(">=2.0 <3.0").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				SemVerConstraint("SUPPORTED_API", "the range of supported API versions").
+				WithDefault(">=2.0 <3.0").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
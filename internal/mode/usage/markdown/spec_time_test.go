@@ -0,0 +1,89 @@
+package markdown_test
+
+import (
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"time spec",
+	tableTest(
+		"spec/time",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Time("LICENSE_EXPIRY", "the time at which the license expires").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Time("LICENSE_EXPIRY", "the time at which the license expires").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Time("LICENSE_EXPIRY", "the time at which the license expires").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Time("LICENSE_EXPIRY", "the time at which the license expires").
+				WithDefault(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Time("LICENSE_EXPIRY", "the time at which the license expires").
+				WithDefault(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with limits",
+		"with-limits.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Time("LICENSE_EXPIRY", "the time at which the license expires").
+				WithLayouts(ferrite.DateLayout).
+				WithMinimum(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).
+				WithMaximum(time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC)).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with multiple layouts",
+		"with-layouts.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				Time("LICENSE_EXPIRY", "the time at which the license expires").
+				WithLayouts(ferrite.RFC3339Layout, ferrite.DateLayout, ferrite.UnixLayout).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
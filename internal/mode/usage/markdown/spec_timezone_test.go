@@ -0,0 +1,68 @@
+package markdown_test
+
+import (
+	"time"
+	_ "time/tzdata" // ensure the location-based example is always available
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"time zone spec",
+	tableTest(
+		"spec/timezone",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TimeZone("REPORT_TZ", "the time zone used when generating reports").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TimeZone("REPORT_TZ", "the time zone used when generating reports").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TimeZone("REPORT_TZ", "the time zone used when generating reports").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TimeZone("REPORT_TZ", "the time zone used when generating reports").
+				WithDefault(time.UTC).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TimeZone("REPORT_TZ", "the time zone used when generating reports").
+				WithDefault(time.UTC).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
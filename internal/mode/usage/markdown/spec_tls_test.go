@@ -0,0 +1,61 @@
+package markdown_test
+
+import (
+	"crypto/tls"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"tls spec",
+	tableTest(
+		"spec/tls",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TLS("HTTPS").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TLS("HTTPS").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TLS("HTTPS").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with default values",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				TLS("HTTPS").
+				WithDefault("/etc/tls/cert.pem", "/etc/tls/key.pem").
+				WithDefaultCA("/etc/tls/ca.pem").
+				WithDefaultClientCA("/etc/tls/client-ca.pem").
+				WithDefaultMinVersion(tls.VersionTLS13).
+				WithDefaultClientAuth(tls.RequireAndVerifyClientCert).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
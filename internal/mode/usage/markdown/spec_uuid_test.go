@@ -0,0 +1,75 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"uuid spec",
+	tableTest(
+		"spec/uuid",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this installation").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this installation").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this installation").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this installation").
+				WithDefault("f47ac10b-58cc-4372-a567-0e02b2c3d479").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this installation").
+				WithDefault("f47ac10b-58cc-4372-a567-0e02b2c3d479").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with version",
+		"with-version.md",
+		func(reg *variable.Registry) {
+			ferrite.
+				UUID("TENANT_ID", "the ID of the tenant that owns this installation").
+				WithVersion(7).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
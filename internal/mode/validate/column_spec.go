@@ -69,6 +69,12 @@ func (r *schemaRenderer) VisitNumeric(s variable.Numeric) {
 			"... %s",
 			max.Quote(),
 		)
+	} else if n, ok := bigNumericTypeNames[s.Type()]; ok {
+		fmt.Fprintf(
+			r.Output,
+			"<%s>",
+			n,
+		)
 	} else {
 		fmt.Fprintf(
 			r.Output,
@@ -82,6 +88,59 @@ func (r *schemaRenderer) VisitString(s variable.String) {
 	fmt.Fprintf(r.Output, "<%s>", s.Type().Kind())
 }
 
+func (r *schemaRenderer) VisitList(s variable.List) {
+	fmt.Fprintf(
+		r.Output,
+		"%s%s ...",
+		renderElement(s.Element()),
+		s.Separator(),
+	)
+}
+
+func (r *schemaRenderer) VisitMap(s variable.Map) {
+	key := renderElement(s.Key())
+
+	if allowed := s.AllowedKeys(); len(allowed) != 0 {
+		var keys []string
+		for _, k := range allowed {
+			keys = append(keys, k.Quote())
+		}
+
+		key = strings.Join(keys, " | ")
+		if len(keys) > 1 {
+			key = "(" + key + ")"
+		}
+	}
+
+	fmt.Fprintf(
+		r.Output,
+		"%s%s%s%s ...",
+		key,
+		s.KeyValueSeparator(),
+		renderElement(s.Value()),
+		s.PairSeparator(),
+	)
+}
+
+func (r *schemaRenderer) VisitBinary(s variable.Binary) {
+	fmt.Fprintf(r.Output, "<%s>", s.Encoding().Name())
+}
+
+// renderElement renders the schema of an element within a list or map,
+// wrapping it in parentheses if it contains whitespace.
+func renderElement(s variable.Schema) string {
+	out := &strings.Builder{}
+	s.AcceptVisitor(&schemaRenderer{
+		Output: out,
+	})
+
+	if strings.Contains(out.String(), " ") {
+		return "(" + out.String() + ")"
+	}
+
+	return out.String()
+}
+
 func (r *schemaRenderer) VisitOther(s variable.Other) {
 	t := s.Type()
 
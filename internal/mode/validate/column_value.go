@@ -40,6 +40,14 @@ func value(v variable.Any) string {
 		return fmt.Sprintf("%s undefined", iconNeutral)
 
 	case variable.SourceDefault:
+		if err, ok := v.Error().(variable.ValueError); ok {
+			return fmt.Sprintf(
+				"%s default value is invalid, %s",
+				iconError,
+				renderError(s, err),
+			)
+		}
+
 		return fmt.Sprintf("%s using default value", iconOK)
 
 	default:
@@ -71,9 +79,9 @@ func value(v variable.Any) string {
 }
 
 func renderValue(s variable.Spec, v variable.Literal) string {
-	if s.IsSensitive() {
-		return strings.Repeat("*", len(v.String))
+	if r, ok := s.Redact(v); ok {
+		return r.Quote()
 	}
 
-	return v.Quote()
+	return strings.Repeat("*", len(v.String))
 }
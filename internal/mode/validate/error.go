@@ -2,6 +2,8 @@ package validate
 
 import (
 	"fmt"
+	"math/big"
+	"reflect"
 	"strings"
 
 	"github.com/dogmatiq/ferrite/variable"
@@ -10,9 +12,10 @@ import (
 func renderError(s variable.Spec, err variable.ValueError) string {
 	out := &strings.Builder{}
 	err.AcceptVisitor(&errorRenderer{
-		Output: out,
-		Schema: s.Schema(),
-		Error:  err,
+		Output:    out,
+		Schema:    s.Schema(),
+		Cause:     err.Unwrap(),
+		Sensitive: s.IsSensitive(),
 	})
 	return out.String()
 }
@@ -20,7 +23,28 @@ func renderError(s variable.Spec, err variable.ValueError) string {
 type errorRenderer struct {
 	Output *strings.Builder
 	Schema variable.Schema
-	Error  variable.ValueError
+	Cause  error
+
+	// Sensitive is true if the variable's value is sensitive, in which case
+	// the parts of the value that caused the error are not rendered.
+	Sensitive bool
+}
+
+// renderCause renders an error that occurred while validating part of a value
+// against the given schema.
+func (r *errorRenderer) renderCause(s variable.Schema, cause error) {
+	c := &errorRenderer{
+		Output:    r.Output,
+		Schema:    s,
+		Cause:     cause,
+		Sensitive: r.Sensitive,
+	}
+
+	if err, ok := cause.(variable.SchemaError); ok {
+		err.AcceptVisitor(c)
+	} else {
+		c.VisitGenericError(cause)
+	}
 }
 
 func (r *errorRenderer) VisitGenericError(err error) {
@@ -28,7 +52,7 @@ func (r *errorRenderer) VisitGenericError(err error) {
 }
 
 func (r *errorRenderer) VisitSet(s variable.Set) {
-	r.Output.WriteString(r.Error.Unwrap().Error())
+	r.Output.WriteString(r.Cause.Error())
 }
 
 func (r *errorRenderer) VisitSetMembershipError(err variable.SetMembershipError) {
@@ -38,7 +62,9 @@ func (r *errorRenderer) VisitSetMembershipError(err variable.SetMembershipError)
 func (r *errorRenderer) VisitNumeric(s variable.Numeric) {
 	typeName := strings.ToLower(s.Type().Name())
 
-	if s.Type().PkgPath() == "" {
+	if n, ok := bigNumericTypeNames[s.Type()]; ok {
+		typeName = n
+	} else if s.Type().PkgPath() == "" {
 		if strings.Contains(typeName, "int") {
 			typeName = "integer"
 		}
@@ -46,9 +72,13 @@ func (r *errorRenderer) VisitNumeric(s variable.Numeric) {
 
 	fmt.Fprintf(r.Output, "expected %s", typeName)
 
+	// Only show the limits of the underlying type if they are small enough to
+	// be meaningful to a human. Types without a fixed size have no such limits
+	// at all, so there's nothing to show unless the application supplies them.
 	const maxHumanReadableBits = 16
 	min, max, explicit := s.Limits()
-	if explicit || s.Bits() <= maxHumanReadableBits {
+	bits := s.Bits()
+	if explicit || (bits != 0 && bits <= maxHumanReadableBits) {
 		fmt.Fprintf(
 			r.Output,
 			" between %s and %s",
@@ -58,6 +88,13 @@ func (r *errorRenderer) VisitNumeric(s variable.Numeric) {
 	}
 }
 
+// bigNumericTypeNames is a map of the arbitrary-precision numeric types to the
+// names used to describe them.
+var bigNumericTypeNames = map[reflect.Type]string{
+	reflect.TypeOf(&big.Int{}): "integer",
+	reflect.TypeOf(&big.Rat{}): "decimal",
+}
+
 func (r *errorRenderer) VisitMinError(err variable.MinError) {
 	r.Output.WriteString(err.Error())
 }
@@ -67,7 +104,7 @@ func (r *errorRenderer) VisitMaxError(err variable.MaxError) {
 }
 
 func (r *errorRenderer) VisitString(s variable.String) {
-	r.Output.WriteString(r.Error.Unwrap().Error())
+	r.Output.WriteString(r.Cause.Error())
 }
 
 func (r *errorRenderer) VisitMinLengthError(err variable.MinLengthError) {
@@ -78,6 +115,101 @@ func (r *errorRenderer) VisitMaxLengthError(err variable.MaxLengthError) {
 	r.Output.WriteString(err.Error())
 }
 
+func (r *errorRenderer) VisitList(s variable.List) {
+	r.Output.WriteString(r.Cause.Error())
+}
+
+func (r *errorRenderer) VisitMinElementsError(err variable.MinElementsError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitMaxElementsError(err variable.MaxElementsError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitListElementError(err variable.ListElementError) {
+	if r.Sensitive {
+		fmt.Fprintf(
+			r.Output,
+			"element #%d is invalid, ",
+			err.Index+1,
+		)
+	} else {
+		fmt.Fprintf(
+			r.Output,
+			"element #%d (%s) is invalid, ",
+			err.Index+1,
+			err.Literal.Quote(),
+		)
+	}
+	r.renderCause(err.List.Element(), err.Cause)
+}
+
+func (r *errorRenderer) VisitDuplicateElementError(err variable.DuplicateElementError) {
+	if r.Sensitive {
+		fmt.Fprintf(
+			r.Output,
+			"element #%d is a duplicate of element #%d, expected unique elements",
+			err.Index+1,
+			err.Original+1,
+		)
+		return
+	}
+
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitMap(s variable.Map) {
+	r.Output.WriteString(r.Cause.Error())
+}
+
+func (r *errorRenderer) VisitMapKeyError(err variable.MapKeyError) {
+	fmt.Fprintf(
+		r.Output,
+		"key %s is invalid, ",
+		err.Key.Quote(),
+	)
+	r.renderCause(err.Map.Key(), err.Cause)
+}
+
+func (r *errorRenderer) VisitMapValueError(err variable.MapValueError) {
+	if r.Sensitive {
+		fmt.Fprintf(
+			r.Output,
+			"value of key %s is invalid, ",
+			err.Key.Quote(),
+		)
+	} else {
+		fmt.Fprintf(
+			r.Output,
+			"value of key %s (%s) is invalid, ",
+			err.Key.Quote(),
+			err.Value.Quote(),
+		)
+	}
+	r.renderCause(err.Map.Value(), err.Cause)
+}
+
+func (r *errorRenderer) VisitMissingKeyError(err variable.MissingKeyError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitUnexpectedKeyError(err variable.UnexpectedKeyError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitBinary(s variable.Binary) {
+	r.Output.WriteString(r.Cause.Error())
+}
+
+func (r *errorRenderer) VisitMinDecodedLengthError(err variable.MinDecodedLengthError) {
+	r.Output.WriteString(err.Error())
+}
+
+func (r *errorRenderer) VisitMaxDecodedLengthError(err variable.MaxDecodedLengthError) {
+	r.Output.WriteString(err.Error())
+}
+
 func (r *errorRenderer) VisitOther(s variable.Other) {
-	r.Output.WriteString(r.Error.Unwrap().Error())
+	r.Output.WriteString(r.Cause.Error())
 }
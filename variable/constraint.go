@@ -8,6 +8,13 @@ type Constraint interface {
 
 	// IsUserDefined returns true if this constraint was defined by the user.
 	IsUserDefined() bool
+
+	// IsRuntime returns true if this constraint depends on the state of the
+	// system, such as the file system, and not just on the value itself.
+	//
+	// Runtime constraints are checked when the variable's value is resolved,
+	// and not when the specification is built.
+	IsRuntime() bool
 }
 
 // TypedConstraint places a constraint on the variable value in addition to the
@@ -26,9 +33,10 @@ type ConstraintError interface {
 
 // constraint is a function that implements the Constraint interface.
 type constraint[T any] struct {
-	desc  string
-	user  bool
-	check func(T) ConstraintError
+	desc    string
+	user    bool
+	runtime bool
+	check   func(T) ConstraintError
 }
 
 // Description returns a description of the constraint.
@@ -41,6 +49,12 @@ func (c constraint[T]) IsUserDefined() bool {
 	return c.user
 }
 
+// IsRuntime returns true if this constraint depends on the state of the
+// system, and not just on the value itself.
+func (c constraint[T]) IsRuntime() bool {
+	return c.runtime
+}
+
 // Check returns an error if v does not satisfy the constraint.
 func (c constraint[T]) Check(v T) ConstraintError {
 	return c.check(v)
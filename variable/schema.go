@@ -34,6 +34,9 @@ type SchemaVisitor interface {
 	VisitNumeric(Numeric)
 	VisitSet(Set)
 	VisitString(String)
+	VisitList(List)
+	VisitMap(Map)
+	VisitBinary(Binary)
 	VisitOther(Other)
 }
 
@@ -49,6 +52,22 @@ type SchemaErrorVisitor interface {
 	// String errors ...
 	VisitMinLengthError(MinLengthError)
 	VisitMaxLengthError(MaxLengthError)
+
+	// List errors ...
+	VisitMinElementsError(MinElementsError)
+	VisitMaxElementsError(MaxElementsError)
+	VisitListElementError(ListElementError)
+	VisitDuplicateElementError(DuplicateElementError)
+
+	// Map errors ...
+	VisitMapKeyError(MapKeyError)
+	VisitMapValueError(MapValueError)
+	VisitMissingKeyError(MissingKeyError)
+	VisitUnexpectedKeyError(UnexpectedKeyError)
+
+	// Binary errors ...
+	VisitMinDecodedLengthError(MinDecodedLengthError)
+	VisitMaxDecodedLengthError(MaxDecodedLengthError)
 }
 
 // TypedSchema describes the valid values of an environment varible value
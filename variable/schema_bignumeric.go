@@ -0,0 +1,152 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/maybe"
+)
+
+// BigNumber is a constraint for the arbitrary-precision numeric types provided
+// by the math/big package.
+type BigNumber[T any] interface {
+	*big.Int | *big.Rat
+
+	// Cmp compares the number to y, returning -1, 0 or +1.
+	Cmp(y T) int
+}
+
+// TypedBigNumeric is a schema for arbitrary-precision numbers of type T.
+//
+// Unlike TypedNumeric, the underlying type does not impose any limits on the
+// range of permitted values.
+type TypedBigNumeric[T BigNumber[T]] struct {
+	Marshaler            Marshaler[T]
+	NativeMin, NativeMax maybe.Value[T]
+}
+
+var _ Numeric = TypedBigNumeric[*big.Int]{}
+
+// Min returns the minimum permitted value as a literal.
+func (s TypedBigNumeric[T]) Min() (Literal, bool) {
+	return mustMarshal(s.Marshaler, s.NativeMin).Get()
+}
+
+// Max returns the maximum permitted value as a literal.
+func (s TypedBigNumeric[T]) Max() (Literal, bool) {
+	return mustMarshal(s.Marshaler, s.NativeMax).Get()
+}
+
+// Limits returns the range of permitted values.
+//
+// explicit is true if both the minimum and the maximum limits are specified
+// by the application. Arbitrary-precision numbers have no inherent limits, so
+// if explicit is false the missing limits are returned as empty literals.
+func (s TypedBigNumeric[T]) Limits() (min, max Literal, explicit bool) {
+	min, hasMin := s.Min()
+	max, hasMax := s.Max()
+	return min, max, hasMin && hasMax
+}
+
+// Bits is the number of bits used to store the number.
+//
+// Arbitrary-precision numbers do not have a fixed size, so it always returns 0.
+func (s TypedBigNumeric[T]) Bits() int {
+	return 0
+}
+
+// Type returns the type of the native value.
+func (s TypedBigNumeric[T]) Type() reflect.Type {
+	return reflectx.TypeOf[T]()
+}
+
+// Finalize prepares the schema for use.
+//
+// It returns an error if schema is invalid.
+func (s TypedBigNumeric[T]) Finalize() error {
+	if _, err := marshal(s.Marshaler, s.NativeMin); err != nil {
+		return fmt.Errorf("minimum value: %w", err)
+	}
+
+	if _, err := marshal(s.Marshaler, s.NativeMax); err != nil {
+		return fmt.Errorf("maximum value: %w", err)
+	}
+
+	min, hasMin := s.NativeMin.Get()
+	max, hasMax := s.NativeMax.Get()
+	if hasMin && hasMax && min.Cmp(max) > 0 {
+		return errors.New("minimum value must not be greater than the maximum value")
+	}
+
+	return nil
+}
+
+// AcceptVisitor passes s to the appropriate method of v.
+func (s TypedBigNumeric[T]) AcceptVisitor(v SchemaVisitor) {
+	v.VisitNumeric(s)
+}
+
+// Marshal converts a value to its literal representation.
+func (s TypedBigNumeric[T]) Marshal(v T) (Literal, error) {
+	if err := s.validate(v); err != nil {
+		return Literal{}, err
+	}
+
+	return s.Marshaler.Marshal(v)
+}
+
+// Unmarshal converts a literal value to it's native representation.
+func (s TypedBigNumeric[T]) Unmarshal(v Literal) (T, error) {
+	n, err := s.Marshaler.Unmarshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, s.validate(n)
+}
+
+// Examples returns a (possibly empty) set of examples of valid values.
+//
+// Arbitrary-precision numbers have no inherent limits from which to derive
+// other examples, so only the minimum and maximum values are returned.
+func (s TypedBigNumeric[T]) Examples(conservative bool) []TypedExample[T] {
+	var examples []TypedExample[T]
+
+	if v, ok := s.NativeMin.Get(); ok {
+		examples = append(
+			examples,
+			TypedExample[T]{
+				Native:      v,
+				Description: "the minimum accepted value",
+			},
+		)
+	}
+
+	if v, ok := s.NativeMax.Get(); ok {
+		examples = append(
+			examples,
+			TypedExample[T]{
+				Native:      v,
+				Description: "the maximum accepted value",
+			},
+		)
+	}
+
+	return examples
+}
+
+// validate returns an error if v is invalid.
+func (s TypedBigNumeric[T]) validate(v T) error {
+	if min, ok := s.NativeMin.Get(); ok && v.Cmp(min) < 0 {
+		return MinError{s}
+	}
+
+	if max, ok := s.NativeMax.Get(); ok && v.Cmp(max) > 0 {
+		return MaxError{s}
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/maybe"
+)
+
+// Binary is a schema that allows arbitrary binary data, represented as text
+// using a binary-to-text encoding such as base64 or hex.
+type Binary interface {
+	Schema
+
+	// Encoding returns the encoding used to represent the data as text.
+	Encoding() BinaryEncoding
+
+	// MinLength returns the minimum permitted length of the data, in bytes,
+	// after it has been decoded.
+	MinLength() (int, bool)
+
+	// MaxLength returns the maximum permitted length of the data, in bytes,
+	// after it has been decoded.
+	MaxLength() (int, bool)
+}
+
+// BinaryEncoding is an encoding used to represent binary data as text.
+type BinaryEncoding interface {
+	// Name returns a short human-readable name for the encoding, such as
+	// "base64" or "hex".
+	Name() string
+
+	// EncodeToString returns the textual representation of data.
+	EncodeToString(data []byte) string
+
+	// DecodeString returns the binary data represented by s.
+	DecodeString(s string) ([]byte, error)
+}
+
+// TypedBinary is a binary value depicted by type T.
+type TypedBinary[T ~[]byte] struct {
+	Enc            BinaryEncoding
+	MinLen, MaxLen maybe.Value[int]
+}
+
+// Encoding returns the encoding used to represent the data as text.
+func (s TypedBinary[T]) Encoding() BinaryEncoding {
+	return s.Enc
+}
+
+// MinLength returns the minimum permitted length of the data, in bytes, after
+// it has been decoded.
+func (s TypedBinary[T]) MinLength() (int, bool) {
+	return s.MinLen.Get()
+}
+
+// MaxLength returns the maximum permitted length of the data, in bytes, after
+// it has been decoded.
+func (s TypedBinary[T]) MaxLength() (int, bool) {
+	return s.MaxLen.Get()
+}
+
+// Type returns the type of the native value.
+func (s TypedBinary[T]) Type() reflect.Type {
+	return reflectx.TypeOf[T]()
+}
+
+// Finalize prepares the schema for use.
+//
+// It returns an error if schema is invalid.
+func (s TypedBinary[T]) Finalize() error {
+	if s.Enc == nil {
+		return errors.New("encoding must not be nil")
+	}
+
+	min := 1
+
+	if v, ok := s.MinLen.Get(); ok {
+		if v < min {
+			return fmt.Errorf("minimum length: must be at least %d", min)
+		}
+		min = v
+	}
+
+	if v, ok := s.MaxLen.Get(); ok {
+		if v < min {
+			return fmt.Errorf("maximum length: must be at least %d", min)
+		}
+	}
+
+	return nil
+}
+
+// AcceptVisitor passes s to the appropriate method of v.
+func (s TypedBinary[T]) AcceptVisitor(v SchemaVisitor) {
+	v.VisitBinary(s)
+}
+
+// Marshal converts a value to its literal representation.
+func (s TypedBinary[T]) Marshal(v T) (Literal, error) {
+	if err := s.validate(v); err != nil {
+		return Literal{}, err
+	}
+
+	return Literal{
+		String: s.Enc.EncodeToString(v),
+	}, nil
+}
+
+// Unmarshal converts a literal value to it's native representation.
+func (s TypedBinary[T]) Unmarshal(v Literal) (T, error) {
+	data, err := s.Enc.DecodeString(v.String)
+	if err != nil {
+		return nil, err
+	}
+
+	n := T(data)
+	return n, s.validate(n)
+}
+
+// Examples returns a (possibly empty) set of examples of valid values.
+func (s TypedBinary[T]) Examples(hasOtherExamples bool) []TypedExample[T] {
+	if hasOtherExamples {
+		return nil
+	}
+
+	n := 16
+
+	if min, ok := s.MinLen.Get(); ok && min > n {
+		n = min
+	}
+
+	if max, ok := s.MaxLen.Get(); ok && max < n {
+		n = max
+	}
+
+	example := make(T, n)
+	for i := range example {
+		example[i] = byte(i)
+	}
+
+	return []TypedExample[T]{
+		{
+			Native: example,
+		},
+	}
+}
+
+// validate returns an error if v is invalid.
+func (s TypedBinary[T]) validate(v T) error {
+	if min, ok := s.MinLen.Get(); ok && len(v) < min {
+		return MinDecodedLengthError{s, len(v)}
+	}
+
+	if max, ok := s.MaxLen.Get(); ok && len(v) > max {
+		return MaxDecodedLengthError{s, len(v)}
+	}
+
+	return nil
+}
+
+// MinDecodedLengthError indicates that a binary value was shorter than the
+// minimum permitted length once decoded.
+type MinDecodedLengthError struct {
+	Binary Binary
+
+	// Length is the length of the decoded value, in bytes.
+	Length int
+}
+
+var _ SchemaError = MinDecodedLengthError{}
+
+// Schema returns the schema that was violated.
+func (e MinDecodedLengthError) Schema() Schema {
+	return e.Binary
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MinDecodedLengthError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMinDecodedLengthError(e)
+}
+
+func (e MinDecodedLengthError) Error() string {
+	return fmt.Sprintf("too short, %s", explainDecodedLengthError(e.Binary, e.Length))
+}
+
+// MaxDecodedLengthError indicates that a binary value was longer than the
+// maximum permitted length once decoded.
+type MaxDecodedLengthError struct {
+	Binary Binary
+
+	// Length is the length of the decoded value, in bytes.
+	Length int
+}
+
+var _ SchemaError = MaxDecodedLengthError{}
+
+// Schema returns the schema that was violated.
+func (e MaxDecodedLengthError) Schema() Schema {
+	return e.Binary
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MaxDecodedLengthError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMaxDecodedLengthError(e)
+}
+
+func (e MaxDecodedLengthError) Error() string {
+	return fmt.Sprintf("too long, %s", explainDecodedLengthError(e.Binary, e.Length))
+}
+
+func explainDecodedLengthError(s Binary, n int) string {
+	min, hasMin := s.MinLength()
+	max, hasMax := s.MaxLength()
+
+	if !hasMin {
+		return fmt.Sprintf("expected %d bytes or fewer when decoded, got %d", max, n)
+	}
+
+	if !hasMax {
+		return fmt.Sprintf("expected %d bytes or more when decoded, got %d", min, n)
+	}
+
+	if min == max {
+		return fmt.Sprintf("expected exactly %d bytes when decoded, got %d", min, n)
+	}
+
+	return fmt.Sprintf("expected between %d and %d bytes when decoded, got %d", min, max, n)
+}
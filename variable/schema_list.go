@@ -0,0 +1,513 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/maybe"
+)
+
+// List is a schema that allows a delimited list of values, each of which is
+// described by another schema.
+type List interface {
+	Schema
+
+	// Element returns the schema that applies to each element of the list.
+	Element() Schema
+
+	// ElementConstraints returns the constraints that apply to each element of
+	// the list, in addition to the element schema's requirements.
+	ElementConstraints() []Constraint
+
+	// Separator returns the string used to separate the elements of the list.
+	Separator() string
+
+	// MinElements returns the minimum permitted number of elements.
+	MinElements() (int, bool)
+
+	// MaxElements returns the maximum permitted number of elements.
+	MaxElements() (int, bool)
+
+	// IsUnique returns true if the elements of the list must be unique.
+	IsUnique() bool
+}
+
+// TypedList is a list of values of type T.
+type TypedList[T any] struct {
+	Elem            TypedSchema[T]
+	ElemConstraints []TypedConstraint[T]
+	ElemExamples    []TypedExample[T]
+	Sep             string
+	MinLen, MaxLen  maybe.Value[int]
+	Unique          bool
+}
+
+// Element returns the schema that applies to each element of the list.
+func (s TypedList[T]) Element() Schema {
+	return s.Elem
+}
+
+// ElementConstraints returns the constraints that apply to each element of the
+// list, in addition to the element schema's requirements.
+func (s TypedList[T]) ElementConstraints() []Constraint {
+	constraints := make([]Constraint, len(s.ElemConstraints))
+	for i, c := range s.ElemConstraints {
+		constraints[i] = c
+	}
+	return constraints
+}
+
+// Separator returns the string used to separate the elements of the list.
+func (s TypedList[T]) Separator() string {
+	return s.Sep
+}
+
+// MinElements returns the minimum permitted number of elements.
+func (s TypedList[T]) MinElements() (int, bool) {
+	return s.MinLen.Get()
+}
+
+// MaxElements returns the maximum permitted number of elements.
+func (s TypedList[T]) MaxElements() (int, bool) {
+	return s.MaxLen.Get()
+}
+
+// IsUnique returns true if the elements of the list must be unique.
+func (s TypedList[T]) IsUnique() bool {
+	return s.Unique
+}
+
+// Type returns the type of the native value.
+func (s TypedList[T]) Type() reflect.Type {
+	return reflectx.TypeOf[[]T]()
+}
+
+// Finalize prepares the schema for use.
+//
+// It returns an error if schema is invalid.
+func (s TypedList[T]) Finalize() error {
+	if strings.TrimSpace(s.Sep) == "" {
+		return errors.New("separator must not be empty or whitespace")
+	}
+
+	if err := s.Elem.Finalize(); err != nil {
+		return fmt.Errorf("element: %w", err)
+	}
+
+	for _, eg := range s.ElemExamples {
+		if err := s.checkElementConstraints(eg.Native); err != nil {
+			return fmt.Errorf("element example: %w", err)
+		}
+
+		if _, err := s.Elem.Marshal(eg.Native); err != nil {
+			return fmt.Errorf("element example: %w", err)
+		}
+	}
+
+	min := 1
+
+	if v, ok := s.MinLen.Get(); ok {
+		if v < min {
+			return fmt.Errorf("minimum elements: must be at least %d", min)
+		}
+		min = v
+	}
+
+	if v, ok := s.MaxLen.Get(); ok {
+		if v < min {
+			return fmt.Errorf("maximum elements: must be at least %d", min)
+		}
+	}
+
+	return nil
+}
+
+// AcceptVisitor passes s to the appropriate method of v.
+func (s TypedList[T]) AcceptVisitor(v SchemaVisitor) {
+	v.VisitList(s)
+}
+
+// Marshal converts a value to its literal representation.
+//
+// An empty list is represented by an empty literal, which is equivalent to the
+// variable being undefined.
+func (s TypedList[T]) Marshal(v []T) (Literal, error) {
+	if len(v) == 0 {
+		return Literal{}, nil
+	}
+
+	if err := s.validateLength(len(v)); err != nil {
+		return Literal{}, err
+	}
+
+	literals, err := s.marshalElements(v)
+	if err != nil {
+		return Literal{}, err
+	}
+
+	return Literal{
+		String: strings.Join(literals, s.Sep),
+	}, nil
+}
+
+// Unmarshal converts a literal value to it's native representation.
+func (s TypedList[T]) Unmarshal(v Literal) ([]T, error) {
+	parts := strings.Split(v.String, s.Sep)
+
+	if err := s.validateLength(len(parts)); err != nil {
+		return nil, err
+	}
+
+	var (
+		elements  []T
+		canonical []string
+	)
+
+	for i, p := range parts {
+		lit := Literal{
+			String: strings.TrimSpace(p),
+		}
+
+		if lit.String == "" {
+			return nil, ListElementError{s, i, lit, errors.New("must not be empty")}
+		}
+
+		n, err := s.unmarshalElement(lit)
+		if err != nil {
+			return nil, ListElementError{s, i, lit, err}
+		}
+
+		c, err := s.Elem.Marshal(n)
+		if err != nil {
+			// Schema can't marshal a value it just successfully unmarshaled!
+			panic(err)
+		}
+
+		if s.Unique {
+			for j, x := range canonical {
+				if x == c.String {
+					return nil, DuplicateElementError{s, i, j, lit}
+				}
+			}
+		}
+
+		elements = append(elements, n)
+		canonical = append(canonical, c.String)
+	}
+
+	return elements, nil
+}
+
+// Examples returns a (possibly empty) set of examples of valid values.
+func (s TypedList[T]) Examples(bool) []TypedExample[[]T] {
+	max, ok := s.MaxLen.Get()
+	if !ok || max > 3 {
+		max = 3
+	}
+
+	var (
+		elements  []T
+		literals  []string
+		normative = true
+	)
+
+	add := func(egs []TypedExample[T]) {
+		for _, eg := range egs {
+			if len(elements) == max {
+				return
+			}
+
+			if err := s.checkElementConstraints(eg.Native); err != nil {
+				continue
+			}
+
+			lit, err := s.Elem.Marshal(eg.Native)
+			if err != nil || strings.Contains(lit.String, s.Sep) {
+				continue
+			}
+
+			if s.Unique {
+				duplicate := false
+				for _, x := range literals {
+					if x == lit.String {
+						duplicate = true
+						break
+					}
+				}
+				if duplicate {
+					continue
+				}
+			}
+
+			elements = append(elements, eg.Native)
+			literals = append(literals, lit.String)
+			normative = normative && eg.IsNormative
+		}
+	}
+
+	// Prefer the examples that were provided explicitly for the elements, then
+	// fall back to conservative, then non-conservative schema examples.
+	add(s.ElemExamples)
+	add(s.Elem.Examples(true))
+	if len(elements) == 0 {
+		add(s.Elem.Examples(false))
+	}
+
+	if min, ok := s.MinLen.Get(); ok && len(elements) < min {
+		return nil
+	}
+
+	if len(elements) == 0 {
+		return nil
+	}
+
+	return []TypedExample[[]T]{
+		{
+			Native:      elements,
+			IsNormative: normative,
+		},
+	}
+}
+
+// validateLength returns an error if n is not a permitted number of elements.
+func (s TypedList[T]) validateLength(n int) error {
+	if min, ok := s.MinLen.Get(); ok && n < min {
+		return MinElementsError{s}
+	}
+
+	if max, ok := s.MaxLen.Get(); ok && n > max {
+		return MaxElementsError{s}
+	}
+
+	return nil
+}
+
+// marshalElements converts each element of v to its literal representation.
+func (s TypedList[T]) marshalElements(v []T) ([]string, error) {
+	literals := make([]string, 0, len(v))
+
+	for i, n := range v {
+		if err := s.checkElementConstraints(n); err != nil {
+			return nil, fmt.Errorf("element #%d: %w", i+1, err)
+		}
+
+		lit, err := s.Elem.Marshal(n)
+		if err != nil {
+			return nil, fmt.Errorf("element #%d: %w", i+1, err)
+		}
+
+		if strings.Contains(lit.String, s.Sep) {
+			return nil, fmt.Errorf(
+				"element #%d: %s contains the separator (%q)",
+				i+1,
+				lit.Quote(),
+				s.Sep,
+			)
+		}
+
+		if s.Unique {
+			for j, x := range literals {
+				if x == lit.String {
+					return nil, DuplicateElementError{s, i, j, lit}
+				}
+			}
+		}
+
+		literals = append(literals, lit.String)
+	}
+
+	return literals, nil
+}
+
+// unmarshalElement converts a literal to a native element value and checks
+// the element constraints.
+func (s TypedList[T]) unmarshalElement(v Literal) (T, error) {
+	n, err := s.Elem.Unmarshal(v)
+	if err != nil {
+		return n, err
+	}
+
+	return n, s.checkElementConstraints(n)
+}
+
+// checkElementConstraints returns an error if v does not satisfy the element
+// constraints.
+//
+// Runtime constraints are not checked, as they depend on the state of the
+// system at the time the variable's value is resolved, not when it is
+// marshaled or when examples are generated. They are checked by
+// checkRuntimeConstraints() instead.
+func (s TypedList[T]) checkElementConstraints(v T) error {
+	for _, c := range s.ElemConstraints {
+		if c.IsRuntime() {
+			continue
+		}
+
+		if err := c.Check(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRuntimeConstraints returns an error if any element of v does not
+// satisfy the element's runtime constraints.
+func (s TypedList[T]) checkRuntimeConstraints(v []T) ConstraintError {
+	for i, n := range v {
+		for _, c := range s.ElemConstraints {
+			if !c.IsRuntime() {
+				continue
+			}
+
+			if err := c.Check(n); err != nil {
+				lit, _ := s.Elem.Marshal(n)
+				return ListElementError{s, i, lit, err}
+			}
+		}
+	}
+
+	return nil
+}
+
+// MinElementsError indicates that a list contained fewer elements than the
+// minimum permitted number.
+type MinElementsError struct {
+	List List
+}
+
+var _ SchemaError = MinElementsError{}
+
+// Schema returns the schema that was violated.
+func (e MinElementsError) Schema() Schema {
+	return e.List
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MinElementsError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMinElementsError(e)
+}
+
+func (e MinElementsError) Error() string {
+	return fmt.Sprintf("too few elements, %s", explainElementCountError(e.List))
+}
+
+// MaxElementsError indicates that a list contained more elements than the
+// maximum permitted number.
+type MaxElementsError struct {
+	List List
+}
+
+var _ SchemaError = MaxElementsError{}
+
+// Schema returns the schema that was violated.
+func (e MaxElementsError) Schema() Schema {
+	return e.List
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MaxElementsError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMaxElementsError(e)
+}
+
+func (e MaxElementsError) Error() string {
+	return fmt.Sprintf("too many elements, %s", explainElementCountError(e.List))
+}
+
+func explainElementCountError(s List) string {
+	min, hasMin := s.MinElements()
+	max, hasMax := s.MaxElements()
+
+	if !hasMin {
+		return fmt.Sprintf("expected %d or fewer", max)
+	}
+
+	if !hasMax {
+		return fmt.Sprintf("expected %d or more", min)
+	}
+
+	if min == max {
+		return fmt.Sprintf("expected exactly %d", min)
+	}
+
+	return fmt.Sprintf("expected between %d and %d", min, max)
+}
+
+// ListElementError indicates that a specific element of a list is invalid.
+type ListElementError struct {
+	List List
+
+	// Index is the (zero-based) index of the invalid element.
+	Index int
+
+	// Literal is the invalid element value.
+	Literal Literal
+
+	// Cause is the reason the element is invalid.
+	Cause error
+}
+
+var _ SchemaError = ListElementError{}
+
+// Schema returns the schema that was violated.
+func (e ListElementError) Schema() Schema {
+	return e.List
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e ListElementError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitListElementError(e)
+}
+
+func (e ListElementError) Unwrap() error {
+	return e.Cause
+}
+
+func (e ListElementError) Error() string {
+	return fmt.Sprintf(
+		"element #%d (%s) is invalid: %s",
+		e.Index+1,
+		e.Literal.Quote(),
+		e.Cause,
+	)
+}
+
+// DuplicateElementError indicates that a list that requires unique elements
+// contains the same element more than once.
+type DuplicateElementError struct {
+	List List
+
+	// Index is the (zero-based) index of the duplicate element.
+	Index int
+
+	// Original is the (zero-based) index of the first occurrence of the
+	// element.
+	Original int
+
+	// Literal is the duplicated element value.
+	Literal Literal
+}
+
+var _ SchemaError = DuplicateElementError{}
+
+// Schema returns the schema that was violated.
+func (e DuplicateElementError) Schema() Schema {
+	return e.List
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e DuplicateElementError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitDuplicateElementError(e)
+}
+
+func (e DuplicateElementError) Error() string {
+	return fmt.Sprintf(
+		"element #%d (%s) is a duplicate of element #%d, expected unique elements",
+		e.Index+1,
+		e.Literal.Quote(),
+		e.Original+1,
+	)
+}
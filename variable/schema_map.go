@@ -0,0 +1,625 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"golang.org/x/exp/slices"
+)
+
+// Map is a schema that allows a delimited list of key/value pairs, where the
+// keys and values are each described by another schema.
+type Map interface {
+	Schema
+
+	// Key returns the schema that applies to each key.
+	Key() Schema
+
+	// KeyConstraints returns the constraints that apply to each key, in
+	// addition to the key schema's requirements.
+	KeyConstraints() []Constraint
+
+	// Value returns the schema that applies to each value.
+	Value() Schema
+
+	// ValueConstraints returns the constraints that apply to each value, in
+	// addition to the value schema's requirements.
+	ValueConstraints() []Constraint
+
+	// PairSeparator returns the string used to separate each key/value pair.
+	PairSeparator() string
+
+	// KeyValueSeparator returns the string used to separate each key from its
+	// value.
+	KeyValueSeparator() string
+
+	// RequiredKeys returns the keys that must be present, as literals.
+	RequiredKeys() []Literal
+
+	// AllowedKeys returns the keys that may be present, as literals.
+	//
+	// If it is empty any key that satisfies the key schema is allowed.
+	AllowedKeys() []Literal
+}
+
+// TypedMap is a map of keys of type K to values of type V.
+type TypedMap[K comparable, V any] struct {
+	KeyElem              TypedSchema[K]
+	KeyElemConstraints   []TypedConstraint[K]
+	KeyElemExamples      []TypedExample[K]
+	ValueElem            TypedSchema[V]
+	ValueElemConstraints []TypedConstraint[V]
+	ValueElemExamples    []TypedExample[V]
+	PairSep, KeyValueSep string
+	Required             []K
+	Allowed              []K
+}
+
+// Key returns the schema that applies to each key.
+func (s TypedMap[K, V]) Key() Schema {
+	return s.KeyElem
+}
+
+// KeyConstraints returns the constraints that apply to each key, in addition
+// to the key schema's requirements.
+func (s TypedMap[K, V]) KeyConstraints() []Constraint {
+	constraints := make([]Constraint, len(s.KeyElemConstraints))
+	for i, c := range s.KeyElemConstraints {
+		constraints[i] = c
+	}
+	return constraints
+}
+
+// Value returns the schema that applies to each value.
+func (s TypedMap[K, V]) Value() Schema {
+	return s.ValueElem
+}
+
+// ValueConstraints returns the constraints that apply to each value, in
+// addition to the value schema's requirements.
+func (s TypedMap[K, V]) ValueConstraints() []Constraint {
+	constraints := make([]Constraint, len(s.ValueElemConstraints))
+	for i, c := range s.ValueElemConstraints {
+		constraints[i] = c
+	}
+	return constraints
+}
+
+// PairSeparator returns the string used to separate each key/value pair.
+func (s TypedMap[K, V]) PairSeparator() string {
+	return s.PairSep
+}
+
+// KeyValueSeparator returns the string used to separate each key from its
+// value.
+func (s TypedMap[K, V]) KeyValueSeparator() string {
+	return s.KeyValueSep
+}
+
+// RequiredKeys returns the keys that must be present, as literals.
+func (s TypedMap[K, V]) RequiredKeys() []Literal {
+	return s.mustMarshalKeys(s.Required)
+}
+
+// AllowedKeys returns the keys that may be present, as literals.
+//
+// If it is empty any key that satisfies the key schema is allowed.
+func (s TypedMap[K, V]) AllowedKeys() []Literal {
+	return s.mustMarshalKeys(s.Allowed)
+}
+
+// Type returns the type of the native value.
+func (s TypedMap[K, V]) Type() reflect.Type {
+	return reflectx.TypeOf[map[K]V]()
+}
+
+// Finalize prepares the schema for use.
+//
+// It returns an error if schema is invalid.
+func (s TypedMap[K, V]) Finalize() error {
+	if strings.TrimSpace(s.PairSep) == "" {
+		return errors.New("pair separator must not be empty or whitespace")
+	}
+
+	if strings.TrimSpace(s.KeyValueSep) == "" {
+		return errors.New("key/value separator must not be empty or whitespace")
+	}
+
+	if strings.Contains(s.PairSep, s.KeyValueSep) || strings.Contains(s.KeyValueSep, s.PairSep) {
+		return errors.New("pair separator and key/value separator must not overlap")
+	}
+
+	if err := s.KeyElem.Finalize(); err != nil {
+		return fmt.Errorf("key: %w", err)
+	}
+
+	if err := s.ValueElem.Finalize(); err != nil {
+		return fmt.Errorf("value: %w", err)
+	}
+
+	allowed, err := s.marshalKeys(s.Allowed)
+	if err != nil {
+		return fmt.Errorf("allowed key: %w", err)
+	}
+
+	required, err := s.marshalKeys(s.Required)
+	if err != nil {
+		return fmt.Errorf("required key: %w", err)
+	}
+
+	if len(allowed) != 0 {
+		for _, k := range required {
+			if !slices.Contains(allowed, k) {
+				return fmt.Errorf("required key %s is not one of the allowed keys", k.Quote())
+			}
+		}
+	}
+
+	return nil
+}
+
+// AcceptVisitor passes s to the appropriate method of v.
+func (s TypedMap[K, V]) AcceptVisitor(v SchemaVisitor) {
+	v.VisitMap(s)
+}
+
+// Marshal converts a value to its literal representation.
+//
+// Pairs are sorted by the literal representation of their keys. An empty map is
+// represented by an empty literal, which is equivalent to the variable being
+// undefined.
+func (s TypedMap[K, V]) Marshal(v map[K]V) (Literal, error) {
+	if len(v) == 0 {
+		return Literal{}, nil
+	}
+
+	type pair struct {
+		key, value string
+	}
+
+	var pairs []pair
+
+	for k, x := range v {
+		key, err := s.marshalKey(k)
+		if err != nil {
+			return Literal{}, err
+		}
+
+		value, err := s.marshalValue(x)
+		if err != nil {
+			return Literal{}, fmt.Errorf("value of key %s: %w", key.Quote(), err)
+		}
+
+		pairs = append(pairs, pair{key.String, value.String})
+	}
+
+	if err := s.validateKeys(func(k Literal) bool {
+		for _, p := range pairs {
+			if p.key == k.String {
+				return true
+			}
+		}
+		return false
+	}); err != nil {
+		return Literal{}, err
+	}
+
+	slices.SortFunc(
+		pairs,
+		func(a, b pair) bool {
+			return a.key < b.key
+		},
+	)
+
+	literals := make([]string, len(pairs))
+	for i, p := range pairs {
+		literals[i] = p.key + s.KeyValueSep + p.value
+	}
+
+	return Literal{
+		String: strings.Join(literals, s.PairSep),
+	}, nil
+}
+
+// Unmarshal converts a literal value to it's native representation.
+func (s TypedMap[K, V]) Unmarshal(v Literal) (map[K]V, error) {
+	result := map[K]V{}
+	keys := map[Literal]struct{}{}
+
+	for i, p := range strings.Split(v.String, s.PairSep) {
+		p = strings.TrimSpace(p)
+
+		// The pair itself is not included in the error message, as it may
+		// contain a sensitive value.
+		k, x, ok := strings.Cut(p, s.KeyValueSep)
+		if !ok {
+			return nil, fmt.Errorf(
+				"pair #%d is missing the %q separator",
+				i+1,
+				s.KeyValueSep,
+			)
+		}
+
+		key := Literal{String: strings.TrimSpace(k)}
+		value := Literal{String: strings.TrimSpace(x)}
+
+		if key.String == "" {
+			return nil, fmt.Errorf("pair #%d has an empty key", i+1)
+		}
+
+		nk, err := s.unmarshalKey(key)
+		if err != nil {
+			return nil, MapKeyError{s, key, err}
+		}
+
+		canonical, err := s.KeyElem.Marshal(nk)
+		if err != nil {
+			// Schema can't marshal a value it just successfully unmarshaled!
+			panic(err)
+		}
+
+		if allowed := s.AllowedKeys(); len(allowed) != 0 && !slices.Contains(allowed, canonical) {
+			return nil, UnexpectedKeyError{s, key}
+		}
+
+		if _, ok := keys[canonical]; ok {
+			return nil, fmt.Errorf("key %s is specified more than once", key.Quote())
+		}
+		keys[canonical] = struct{}{}
+
+		if value.String == "" {
+			return nil, MapValueError{s, key, value, errors.New("must not be empty")}
+		}
+
+		nv, err := s.unmarshalValue(value)
+		if err != nil {
+			return nil, MapValueError{s, key, value, err}
+		}
+
+		result[nk] = nv
+	}
+
+	if err := s.validateKeys(func(k Literal) bool {
+		_, ok := keys[k]
+		return ok
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Examples returns a (possibly empty) set of examples of valid values.
+func (s TypedMap[K, V]) Examples(bool) []TypedExample[map[K]V] {
+	var keys []K
+
+	if len(s.Required) != 0 {
+		keys = s.Required
+	} else if len(s.Allowed) != 0 {
+		keys = s.Allowed
+		if len(keys) > 2 {
+			keys = keys[:2]
+		}
+	} else {
+		for _, eg := range s.candidateKeys() {
+			if len(keys) == 2 {
+				break
+			}
+			keys = append(keys, eg)
+		}
+	}
+
+	values := s.candidateValues()
+
+	if len(keys) == 0 || len(values) == 0 {
+		return nil
+	}
+
+	m := map[K]V{}
+	for i, k := range keys {
+		m[k] = values[i%len(values)]
+	}
+
+	if _, err := s.Marshal(m); err != nil {
+		return nil
+	}
+
+	return []TypedExample[map[K]V]{
+		{
+			Native: m,
+		},
+	}
+}
+
+// candidateKeys returns valid keys from the key examples.
+func (s TypedMap[K, V]) candidateKeys() []K {
+	var (
+		keys     []K
+		literals []Literal
+	)
+
+	for _, egs := range [][]TypedExample[K]{
+		s.KeyElemExamples,
+		s.KeyElem.Examples(true),
+		s.KeyElem.Examples(false),
+	} {
+		for _, eg := range egs {
+			lit, err := s.marshalKey(eg.Native)
+			if err != nil || slices.Contains(literals, lit) {
+				continue
+			}
+
+			keys = append(keys, eg.Native)
+			literals = append(literals, lit)
+		}
+	}
+
+	return keys
+}
+
+// candidateValues returns valid values from the value examples.
+func (s TypedMap[K, V]) candidateValues() []V {
+	var values []V
+
+	for _, egs := range [][]TypedExample[V]{
+		s.ValueElemExamples,
+		s.ValueElem.Examples(true),
+		s.ValueElem.Examples(false),
+	} {
+		for _, eg := range egs {
+			if _, err := s.marshalValue(eg.Native); err == nil {
+				values = append(values, eg.Native)
+			}
+		}
+	}
+
+	return values
+}
+
+// validateKeys returns an error if the keys that are present (as reported by
+// the has function) do not include all required keys.
+func (s TypedMap[K, V]) validateKeys(has func(Literal) bool) error {
+	for _, k := range s.RequiredKeys() {
+		if !has(k) {
+			return MissingKeyError{s, k}
+		}
+	}
+
+	return nil
+}
+
+// marshalKey converts a key to its literal representation, ensuring it
+// satisfies the key constraints and the allow-list.
+func (s TypedMap[K, V]) marshalKey(k K) (Literal, error) {
+	if err := checkConstraints(s.KeyElemConstraints, k); err != nil {
+		return Literal{}, err
+	}
+
+	lit, err := s.KeyElem.Marshal(k)
+	if err != nil {
+		return Literal{}, err
+	}
+
+	if strings.Contains(lit.String, s.PairSep) || strings.Contains(lit.String, s.KeyValueSep) {
+		return Literal{}, fmt.Errorf("key %s contains a separator", lit.Quote())
+	}
+
+	if allowed := s.AllowedKeys(); len(allowed) != 0 && !slices.Contains(allowed, lit) {
+		return Literal{}, UnexpectedKeyError{s, lit}
+	}
+
+	return lit, nil
+}
+
+// marshalValue converts a value to its literal representation, ensuring it
+// satisfies the value constraints.
+func (s TypedMap[K, V]) marshalValue(v V) (Literal, error) {
+	if err := checkConstraints(s.ValueElemConstraints, v); err != nil {
+		return Literal{}, err
+	}
+
+	lit, err := s.ValueElem.Marshal(v)
+	if err != nil {
+		return Literal{}, err
+	}
+
+	if strings.Contains(lit.String, s.PairSep) {
+		return Literal{}, fmt.Errorf("%s contains the pair separator (%q)", lit.Quote(), s.PairSep)
+	}
+
+	return lit, nil
+}
+
+// unmarshalKey converts a literal to a native key, ensuring it satisfies the
+// key constraints.
+func (s TypedMap[K, V]) unmarshalKey(v Literal) (K, error) {
+	k, err := s.KeyElem.Unmarshal(v)
+	if err != nil {
+		return k, err
+	}
+
+	return k, checkConstraints(s.KeyElemConstraints, k)
+}
+
+// unmarshalValue converts a literal to a native value, ensuring it satisfies
+// the value constraints.
+func (s TypedMap[K, V]) unmarshalValue(v Literal) (V, error) {
+	x, err := s.ValueElem.Unmarshal(v)
+	if err != nil {
+		return x, err
+	}
+
+	return x, checkConstraints(s.ValueElemConstraints, x)
+}
+
+// marshalKeys converts each of the given keys to its literal representation.
+func (s TypedMap[K, V]) marshalKeys(keys []K) ([]Literal, error) {
+	literals := make([]Literal, len(keys))
+
+	for i, k := range keys {
+		lit, err := s.KeyElem.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+
+		literals[i] = lit
+	}
+
+	return literals, nil
+}
+
+// mustMarshalKeys converts each of the given keys to its literal
+// representation, or panics if unable to do so.
+func (s TypedMap[K, V]) mustMarshalKeys(keys []K) []Literal {
+	literals, err := s.marshalKeys(keys)
+	if err != nil {
+		panic(err)
+	}
+	return literals
+}
+
+// MapKeyError indicates that a key within a map is invalid.
+type MapKeyError struct {
+	Map Map
+
+	// Key is the invalid key.
+	Key Literal
+
+	// Cause is the reason the key is invalid.
+	Cause error
+}
+
+var _ SchemaError = MapKeyError{}
+
+// Schema returns the schema that was violated.
+func (e MapKeyError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MapKeyError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMapKeyError(e)
+}
+
+func (e MapKeyError) Unwrap() error {
+	return e.Cause
+}
+
+func (e MapKeyError) Error() string {
+	return fmt.Sprintf("key %s is invalid: %s", e.Key.Quote(), e.Cause)
+}
+
+// MapValueError indicates that the value associated with a specific key within
+// a map is invalid.
+type MapValueError struct {
+	Map Map
+
+	// Key is the key associated with the invalid value.
+	Key Literal
+
+	// Value is the invalid value.
+	Value Literal
+
+	// Cause is the reason the value is invalid.
+	Cause error
+}
+
+var _ SchemaError = MapValueError{}
+
+// Schema returns the schema that was violated.
+func (e MapValueError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MapValueError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMapValueError(e)
+}
+
+func (e MapValueError) Unwrap() error {
+	return e.Cause
+}
+
+func (e MapValueError) Error() string {
+	return fmt.Sprintf(
+		"value of key %s (%s) is invalid: %s",
+		e.Key.Quote(),
+		e.Value.Quote(),
+		e.Cause,
+	)
+}
+
+// MissingKeyError indicates that a map does not contain one of its required
+// keys.
+type MissingKeyError struct {
+	Map Map
+
+	// Key is the missing key.
+	Key Literal
+}
+
+var _ SchemaError = MissingKeyError{}
+
+// Schema returns the schema that was violated.
+func (e MissingKeyError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MissingKeyError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMissingKeyError(e)
+}
+
+func (e MissingKeyError) Error() string {
+	return fmt.Sprintf("required key %s is missing", e.Key.Quote())
+}
+
+// UnexpectedKeyError indicates that a map contains a key that is not one of
+// its allowed keys.
+type UnexpectedKeyError struct {
+	Map Map
+
+	// Key is the unexpected key.
+	Key Literal
+}
+
+var _ SchemaError = UnexpectedKeyError{}
+
+// Schema returns the schema that was violated.
+func (e UnexpectedKeyError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e UnexpectedKeyError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitUnexpectedKeyError(e)
+}
+
+func (e UnexpectedKeyError) Error() string {
+	allowed := e.Map.AllowedKeys()
+	quoted := make([]string, len(allowed))
+	for i, k := range allowed {
+		quoted[i] = k.Quote()
+	}
+
+	return fmt.Sprintf(
+		"key %s is not permitted, expected one of %s",
+		e.Key.Quote(),
+		strings.Join(quoted, ", "),
+	)
+}
+
+// checkConstraints returns an error if v does not satisfy all of the given
+// constraints.
+func checkConstraints[T any](constraints []TypedConstraint[T], v T) error {
+	for _, c := range constraints {
+		if err := c.Check(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
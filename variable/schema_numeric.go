@@ -31,7 +31,8 @@ type Numeric interface {
 	// of the underlying type.
 	Limits() (min, max Literal, explicit bool)
 
-	// Bits is the number of bits used to store the number.
+	// Bits is the number of bits used to store the number, or 0 if the number
+	// does not have a fixed size and therefore has no inherent limits.
 	Bits() int
 }
 
@@ -14,12 +14,29 @@ type Other interface {
 	Schema
 }
 
+// RequirementDescriber is an optional interface for schemas that can describe
+// the values they accept.
+//
+// It allows the schema's requirement to be documented without registering a
+// constraint that does not perform any checks of its own.
+type RequirementDescriber interface {
+	// RequirementDescription returns a human-readable description of the
+	// values accepted by the schema, such as "**MUST** be a valid UUID".
+	//
+	// It returns an empty string if the schema has no such description.
+	RequirementDescription() string
+}
+
 // TypedOther is a schema for representing values of arbitrary types.
 //
 // It should be used as a last resort when no other schema offers a better
 // explanation of the value.
 type TypedOther[T any] struct {
 	Marshaler Marshaler[T]
+
+	// Requirement is an optional human-readable description of the values
+	// accepted by the schema, such as "**MUST** be a valid UUID".
+	Requirement string
 }
 
 // Type returns the type of the native value.
@@ -27,6 +44,12 @@ func (s TypedOther[T]) Type() reflect.Type {
 	return reflectx.TypeOf[T]()
 }
 
+// RequirementDescription returns a human-readable description of the values
+// accepted by the schema.
+func (s TypedOther[T]) RequirementDescription() string {
+	return s.Requirement
+}
+
 // Finalize prepares the schema for use.
 //
 // It returns an error if schema is invalid.
@@ -23,6 +23,16 @@ type String interface {
 // TypedString is a string value depicted by type T.
 type TypedString[T ~string] struct {
 	MinLen, MaxLen maybe.Value[int]
+
+	// Requirement is an optional human-readable description of the values
+	// accepted by the schema, such as "**MUST** be the path to a directory".
+	Requirement string
+}
+
+// RequirementDescription returns a human-readable description of the values
+// accepted by the schema.
+func (s TypedString[T]) RequirementDescription() string {
+	return s.Requirement
 }
 
 // MinLength returns the minimum permitted length of the string.
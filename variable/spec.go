@@ -31,6 +31,13 @@ type Spec interface {
 	// information.
 	IsSensitive() bool
 
+	// Redact returns v with any sensitive information obscured.
+	//
+	// If the variable is not sensitive v is returned unchanged. ok is false if
+	// the sensitive information can not be isolated from the rest of v, in
+	// which case v must not be displayed at all.
+	Redact(v Literal) (_ Literal, ok bool)
+
 	// IsDeprecated returns true if the variable is deprecated.
 	IsDeprecated() bool
 
@@ -69,6 +76,7 @@ type TypedSpec[T any] struct {
 	def           maybe.Value[valueOf[T]]
 	required      bool
 	sensitive     bool
+	redact        func(Literal) (Literal, bool)
 	deprecated    bool
 	schema        TypedSchema[T]
 	examples      []Example
@@ -120,6 +128,23 @@ func (s *TypedSpec[T]) IsSensitive() bool {
 	return s.sensitive
 }
 
+// Redact returns v with any sensitive information obscured.
+//
+// If the variable is not sensitive v is returned unchanged. ok is false if the
+// sensitive information can not be isolated from the rest of v, in which case
+// v must not be displayed at all.
+func (s *TypedSpec[T]) Redact(v Literal) (_ Literal, ok bool) {
+	if !s.sensitive {
+		return v, true
+	}
+
+	if s.redact == nil {
+		return Literal{}, false
+	}
+
+	return s.redact(v)
+}
+
 // IsDeprecated returns true if the variable is deprecated.
 func (s *TypedSpec[T]) IsDeprecated() bool {
 	return s.deprecated
@@ -157,8 +182,14 @@ func (s *TypedSpec[T]) addRelationship(r Relationship) {
 
 // CheckConstraints returns an error if v does not satisfy any one of the
 // specification's constraints.
+//
+// Runtime constraints are not checked, use CheckRuntimeConstraints() instead.
 func (s *TypedSpec[T]) CheckConstraints(v T) ConstraintError {
 	for _, c := range s.constraints {
+		if c.IsRuntime() {
+			continue
+		}
+
 		if err := c.Check(v); err != nil {
 			return err
 		}
@@ -167,6 +198,32 @@ func (s *TypedSpec[T]) CheckConstraints(v T) ConstraintError {
 	return nil
 }
 
+// CheckRuntimeConstraints returns an error if v does not satisfy any one of the
+// specification's runtime constraints.
+func (s *TypedSpec[T]) CheckRuntimeConstraints(v T) ConstraintError {
+	for _, c := range s.constraints {
+		if !c.IsRuntime() {
+			continue
+		}
+
+		if err := c.Check(v); err != nil {
+			return err
+		}
+	}
+
+	if rs, ok := s.schema.(runtimeConstrainedSchema[T]); ok {
+		return rs.checkRuntimeConstraints(v)
+	}
+
+	return nil
+}
+
+// runtimeConstrainedSchema is a schema that has runtime constraints of its
+// own, such as a list with runtime constraints on each element.
+type runtimeConstrainedSchema[T any] interface {
+	checkRuntimeConstraints(T) ConstraintError
+}
+
 // Marshal converts a value to its literal representation.
 //
 // It returns an error if v does not meet the specification's constraints or
@@ -181,8 +238,8 @@ func (s *TypedSpec[T]) Marshal(v T) (Literal, error) {
 
 // Unmarshal converts a literal value to it's native representation.
 //
-// It returns an error if v does not meet the specification's constraints or
-// unmarshaling fails at the schema level.
+// It returns an error if v does not meet the specification's constraints
+// (including runtime constraints) or unmarshaling fails at the schema level.
 func (s *TypedSpec[T]) Unmarshal(v Literal) (T, Literal, error) {
 	n, err := s.schema.Unmarshal(v)
 	if err != nil {
@@ -193,6 +250,10 @@ func (s *TypedSpec[T]) Unmarshal(v Literal) (T, Literal, error) {
 		return n, Literal{}, err
 	}
 
+	if err := s.CheckRuntimeConstraints(n); err != nil {
+		return n, Literal{}, err
+	}
+
 	c, err := s.schema.Marshal(n)
 	if err != nil {
 		// Schema can't marshal a value it just successfully unmarshaled!
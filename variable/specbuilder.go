@@ -41,6 +41,11 @@ func (b *TypedSpecBuilder[T]) Default(v T) {
 	b.def = maybe.Some(v)
 }
 
+// HasDefault returns true if a default value has been set.
+func (b *TypedSpecBuilder[T]) HasDefault() bool {
+	return !b.def.IsEmpty()
+}
+
 // BuiltInConstraint adds a constraint to the variable's value.
 //
 // If fn was supplied by the application developer (as opposed to from within
@@ -51,7 +56,24 @@ func (b *TypedSpecBuilder[T]) BuiltInConstraint(
 ) {
 	b.spec.constraints = append(
 		b.spec.constraints,
-		constraint[T]{desc, false, fn},
+		constraint[T]{desc, false, false, fn},
+	)
+}
+
+// BuiltInRuntimeConstraint adds a constraint to the variable's value that
+// depends on the state of the system, such as the file system.
+//
+// Unlike other constraints, runtime constraints are not checked against the
+// default value or examples when the specification is built. Instead, they are
+// checked when the variable's value is resolved, regardless of whether the
+// value is obtained from the environment or the default value.
+func (b *TypedSpecBuilder[T]) BuiltInRuntimeConstraint(
+	desc string,
+	fn func(T) ConstraintError,
+) {
+	b.spec.constraints = append(
+		b.spec.constraints,
+		constraint[T]{desc, false, true, fn},
 	)
 }
 
@@ -65,6 +87,7 @@ func (b *TypedSpecBuilder[T]) UserConstraint(
 		constraint[T]{
 			desc,
 			true,
+			false,
 			func(v T) ConstraintError {
 				if fn(v) {
 					return nil
@@ -76,6 +99,16 @@ func (b *TypedSpecBuilder[T]) UserConstraint(
 	)
 }
 
+// Constraints returns the constraints that have been added to the variable.
+func (b *TypedSpecBuilder[T]) Constraints() []TypedConstraint[T] {
+	return b.spec.constraints
+}
+
+// Examples returns the examples that have been added to the variable.
+func (b *TypedSpecBuilder[T]) Examples() []TypedExample[T] {
+	return b.examples
+}
+
 // MarkRequired marks the variable as required.
 func (b *TypedSpecBuilder[T]) MarkRequired() {
 	b.spec.required = true
@@ -86,6 +119,17 @@ func (b *TypedSpecBuilder[T]) MarkSensitive() {
 	b.spec.sensitive = true
 }
 
+// MarkPartiallySensitive marks the variable's content as sensitive, where
+// only some parts of the value contain sensitive information.
+//
+// redact returns the value with its sensitive parts obscured. It returns false
+// if the sensitive parts can not be isolated, such as when the value is
+// malformed, in which case the entire value is treated as sensitive.
+func (b *TypedSpecBuilder[T]) MarkPartiallySensitive(redact func(Literal) (Literal, bool)) {
+	b.spec.sensitive = true
+	b.spec.redact = redact
+}
+
 // MarkDeprecated marks the variable as deprecated.
 func (b *TypedSpecBuilder[T]) MarkDeprecated() {
 	b.spec.deprecated = true
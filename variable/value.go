@@ -75,9 +75,10 @@ type ValueErrorVisitor interface {
 
 // valueError indicates that there is a problem with a variable's value.
 type valueError struct {
-	name    string
-	literal Literal
-	cause   error
+	name      string
+	literal   Literal
+	cause     error
+	isDefault bool
 }
 
 func (e valueError) Name() string {
@@ -102,6 +103,15 @@ func (e valueError) AcceptVisitor(v ValueErrorVisitor) {
 }
 
 func (e valueError) Error() string {
+	if e.isDefault {
+		return fmt.Sprintf(
+			"default value of %s (%s) is invalid: %s",
+			e.name,
+			e.literal.Quote(),
+			e.cause,
+		)
+	}
+
 	return fmt.Sprintf(
 		"value of %s (%s) is invalid: %s",
 		e.name,
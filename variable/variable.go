@@ -131,8 +131,20 @@ func (v *OfType[T]) resolve() {
 
 		if lit.String == "" {
 			if def, ok := v.spec.def.Get(); ok {
-				v.availability = AvailabilityOK
 				v.source = SourceDefault
+
+				if err := v.spec.CheckRuntimeConstraints(def.native); err != nil {
+					v.availability = AvailabilityInvalid
+					v.err = valueError{
+						name:      v.spec.name,
+						literal:   def.canonical,
+						cause:     err,
+						isDefault: true,
+					}
+					return
+				}
+
+				v.availability = AvailabilityOK
 				v.value = def
 			} else if v.spec.required {
 				v.availability = AvailabilityNone
@@ -0,0 +1,149 @@
+package ferrite
+
+import (
+	"github.com/dogmatiq/ferrite/variable"
+)
+
+// Transform returns a Required set that produces a value derived from the
+// value of another set, s.
+//
+// fn is called with the value of s each time the derived value is requested.
+// It must not have side-effects. If any of the environment variables in s is
+// undefined or invalid, Value() panics with the same message as s.
+//
+// The derived set contains the same environment variables as s, so it may be
+// used with RelevantIf() and SeeAlso() in place of s. Transform is equivalent
+// to a "map" operation; it is named so as not to be confused with Map().
+func Transform[T, U any](s Required[T], fn func(T) U) Required[U] {
+	return requiredFunc[U]{
+		s.variables(),
+		func() (U, error) {
+			v, err := s.resolve()
+			if err != nil {
+				var zero U
+				return zero, err
+			}
+
+			return fn(v), nil
+		},
+	}
+}
+
+// TransformOptional returns an Optional set that produces a value derived from
+// the value of another set, s.
+//
+// fn is called with the value of s each time the derived value is requested,
+// provided that s has a value. It must not have side-effects. If any of the
+// environment variables in s is invalid, Value() panics with the same message
+// as s.
+func TransformOptional[T, U any](s Optional[T], fn func(T) U) Optional[U] {
+	return optionalFunc[U]{
+		s.variables(),
+		func() (U, bool, error) {
+			var zero U
+
+			v, ok, err := s.resolve()
+			if err != nil || !ok {
+				return zero, false, err
+			}
+
+			return fn(v), true, nil
+		},
+	}
+}
+
+// Combine returns a Required set that produces a value derived from the values
+// of two other sets, a and b.
+//
+// For example, it may be used to build a URL from separate host and port
+// variables. fn is called with the values of a and b each time the derived
+// value is requested. It must not have side-effects. If any of the environment
+// variables in a or b is undefined or invalid, Value() panics with the same
+// message as the set that contains that variable.
+//
+// The derived set contains the environment variables of both a and b.
+func Combine[A, B, T any](
+	a Required[A],
+	b Required[B],
+	fn func(A, B) T,
+) Required[T] {
+	return requiredFunc[T]{
+		combineVariables(a, b),
+		func() (T, error) {
+			var zero T
+
+			x, err := a.resolve()
+			if err != nil {
+				return zero, err
+			}
+
+			y, err := b.resolve()
+			if err != nil {
+				return zero, err
+			}
+
+			return fn(x, y), nil
+		},
+	}
+}
+
+// CombineOptional returns an Optional set that produces a value derived from
+// the values of two other sets, a and b.
+//
+// The derived set only has a value if both a and b have values. fn is called
+// with those values each time the derived value is requested. It must not have
+// side-effects. If any of the environment variables in a or b is invalid,
+// Value() panics with the same message as the set that contains that variable.
+//
+// The derived set contains the environment variables of both a and b.
+func CombineOptional[A, B, T any](
+	a Optional[A],
+	b Optional[B],
+	fn func(A, B) T,
+) Optional[T] {
+	return optionalFunc[T]{
+		combineVariables(a, b),
+		func() (T, bool, error) {
+			var zero T
+
+			x, xok, err := a.resolve()
+			if err != nil {
+				return zero, false, err
+			}
+
+			y, yok, err := b.resolve()
+			if err != nil {
+				return zero, false, err
+			}
+
+			if !xok || !yok {
+				return zero, false, nil
+			}
+
+			return fn(x, y), true, nil
+		},
+	}
+}
+
+// combineVariables returns the variables in a and b, without duplicates.
+func combineVariables(a, b VariableSet) []variable.Any {
+	vars := append([]variable.Any(nil), a.variables()...)
+
+	for _, v := range b.variables() {
+		if !containsVariable(vars, v) {
+			vars = append(vars, v)
+		}
+	}
+
+	return vars
+}
+
+// containsVariable returns true if vars contains v.
+func containsVariable(vars []variable.Any, v variable.Any) bool {
+	for _, x := range vars {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
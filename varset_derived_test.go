@@ -0,0 +1,223 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/variable"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Transform()", func() {
+	var set Required[string]
+
+	BeforeEach(func() {
+		set = Transform(
+			String("FERRITE_STRING", "<desc>").Required(),
+			strings.ToUpper,
+		)
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("returns the derived value", func() {
+		os.Setenv("FERRITE_STRING", "<value>")
+		Expect(set.Value()).To(Equal("<VALUE>"))
+	})
+
+	It("panics if the underlying variable is undefined", func() {
+		Expect(func() {
+			set.Value()
+		}).To(PanicWith("FERRITE_STRING is undefined and does not have a default value"))
+	})
+})
+
+var _ = Describe("func TransformOptional()", func() {
+	var set Optional[int]
+
+	BeforeEach(func() {
+		set = TransformOptional(
+			Signed[int]("FERRITE_SIGNED", "<desc>").Optional(),
+			func(v int) int { return v * 2 },
+		)
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("returns the derived value", func() {
+		os.Setenv("FERRITE_SIGNED", "123")
+
+		v, ok := set.Value()
+		Expect(ok).To(BeTrue())
+		Expect(v).To(Equal(246))
+	})
+
+	It("returns false if the underlying variable is undefined", func() {
+		_, ok := set.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("panics if the underlying variable is invalid", func() {
+		os.Setenv("FERRITE_SIGNED", "<invalid>")
+
+		Expect(func() {
+			set.Value()
+		}).To(PanicWith("value of FERRITE_SIGNED ('<invalid>') is invalid: unrecognized int syntax"))
+	})
+})
+
+var _ = Describe("func Combine()", func() {
+	var set Required[string]
+
+	BeforeEach(func() {
+		set = Combine(
+			String("FERRITE_HOST", "<desc>").Required(),
+			NetworkPort("FERRITE_PORT", "<desc>").Required(),
+			net.JoinHostPort,
+		)
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("returns the derived value", func() {
+		os.Setenv("FERRITE_HOST", "host.example.org")
+		os.Setenv("FERRITE_PORT", "12345")
+
+		Expect(set.Value()).To(Equal("host.example.org:12345"))
+	})
+
+	It("panics if the first variable is undefined", func() {
+		os.Setenv("FERRITE_PORT", "12345")
+
+		Expect(func() {
+			set.Value()
+		}).To(PanicWith("FERRITE_HOST is undefined and does not have a default value"))
+	})
+
+	It("panics if the second variable is undefined", func() {
+		os.Setenv("FERRITE_HOST", "host.example.org")
+
+		Expect(func() {
+			set.Value()
+		}).To(PanicWith("FERRITE_PORT is undefined and does not have a default value"))
+	})
+
+	It("refers to both variables when used with SeeAlso()", func() {
+		String("FERRITE_PROXY", "<desc>").Optional(SeeAlso(set))
+
+		var names []string
+		for _, s := range variable.DefaultRegistry.Specs() {
+			if s.Name() != "FERRITE_PROXY" {
+				continue
+			}
+
+			for _, r := range s.Relationships() {
+				if r, ok := r.(variable.RefersTo); ok && r.Subject == s {
+					names = append(names, r.RefersTo.Name())
+				}
+			}
+		}
+
+		Expect(names).To(ConsistOf("FERRITE_HOST", "FERRITE_PORT"))
+	})
+})
+
+var _ = Describe("func CombineOptional()", func() {
+	var set Optional[string]
+
+	BeforeEach(func() {
+		set = CombineOptional(
+			String("FERRITE_HOST", "<desc>").Optional(),
+			NetworkPort("FERRITE_PORT", "<desc>").Optional(),
+			net.JoinHostPort,
+		)
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("returns the derived value", func() {
+		os.Setenv("FERRITE_HOST", "host.example.org")
+		os.Setenv("FERRITE_PORT", "12345")
+
+		v, ok := set.Value()
+		Expect(ok).To(BeTrue())
+		Expect(v).To(Equal("host.example.org:12345"))
+	})
+
+	It("returns false if either variable is undefined", func() {
+		os.Setenv("FERRITE_HOST", "host.example.org")
+
+		_, ok := set.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("panics if either variable is invalid", func() {
+		os.Setenv("FERRITE_PORT", "<invalid>")
+
+		Expect(func() {
+			set.Value()
+		}).To(PanicWith("value of FERRITE_PORT ('<invalid>') is invalid: IANA service name must contain only ASCII letters, digits and hyphen"))
+	})
+})
+
+func ExampleCombine() {
+	defer example()()
+
+	url := ferrite.Combine(
+		ferrite.String("FERRITE_HOST", "the API server's hostname").Required(),
+		ferrite.NetworkPort("FERRITE_PORT", "the API server's port").Required(),
+		func(host, port string) string {
+			return "https://" + net.JoinHostPort(host, port) + "/"
+		},
+	)
+
+	os.Setenv("FERRITE_HOST", "api.example.org")
+	os.Setenv("FERRITE_PORT", "8443")
+	ferrite.Init()
+
+	fmt.Println("value is", url.Value())
+
+	// Output:
+	// value is https://api.example.org:8443/
+}
+
+func ExampleTransform_relevantIf() {
+	defer example()()
+
+	level := ferrite.
+		Enum("FERRITE_LOG_LEVEL", "the minimum log level").
+		WithMembers("debug", "info", "warn").
+		Required()
+
+	debug := ferrite.Transform(
+		level,
+		func(v string) bool { return v == "debug" },
+	)
+
+	ferrite.
+		String("FERRITE_TRACE_FILE", "the file to which trace output is written").
+		Required(ferrite.RelevantIf(debug))
+
+	// FERRITE_TRACE_FILE is "required" but we can leave it undefined when the
+	// log level is not "debug".
+	os.Setenv("FERRITE_LOG_LEVEL", "info")
+	ferrite.Init()
+
+	fmt.Println("debug is", debug.Value())
+
+	// Output:
+	// debug is false
+}
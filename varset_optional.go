@@ -17,6 +17,10 @@ type Optional[T any] interface {
 	// If the environment variable(s) are not defined and there is no default
 	// value, ok is false; otherwise, ok is true and v is the value.
 	Value() (T, bool)
+
+	// resolve returns the value, along with an error if any one of the
+	// environment variables in the set has an invalid value.
+	resolve() (T, bool, error)
 }
 
 // OptionalOption is an option that configures an "optional" variable set. It
@@ -69,6 +73,10 @@ func (s optionalFunc[T]) Value() (T, bool) {
 	return n, ok
 }
 
+func (s optionalFunc[T]) resolve() (T, bool, error) {
+	return s.fn()
+}
+
 func (s optionalFunc[T]) value() any {
 	if n, ok, _ := s.fn(); ok {
 		return n
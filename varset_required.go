@@ -15,6 +15,10 @@ type Required[T any] interface {
 	// It panics if any of one of the environment variables in the set is
 	// undefined or has an invalid value.
 	Value() T
+
+	// resolve returns the value, or an error if any one of the environment
+	// variables in the set is undefined or has an invalid value.
+	resolve() (T, error)
 }
 
 // RequiredOption is an option that configures a "required" variable set. It may
@@ -67,6 +71,10 @@ func (s requiredFunc[T]) Value() T {
 	return n
 }
 
+func (s requiredFunc[T]) resolve() (T, error) {
+	return s.fn()
+}
+
 func (s requiredFunc[T]) value() any {
 	if n, err := s.fn(); err == nil {
 		return n